@@ -0,0 +1,69 @@
+package math
+
+// An angle in radians.
+type Radians float32
+
+// An angle in degrees.
+type Degrees float32
+
+// An angle in either unit. APIs taking an Angle accept Radians and Degrees
+// but no plain numbers, so the unit is always explicit.
+type Angle interface {
+	Radians() Radians
+	Degrees() Degrees
+}
+
+func (r Radians) Radians() Radians { return r }
+func (r Radians) Degrees() Degrees { return Degrees(float32(r) * RadiansToDegrees) }
+func (d Degrees) Radians() Radians { return Radians(float32(d) * DegreeToRadians) }
+func (d Degrees) Degrees() Degrees { return d }
+
+func (r Radians) Sin() float32 { return Sin(float32(r)) }
+func (r Radians) Cos() float32 { return Cos(float32(r)) }
+func (r Radians) Tan() float32 { return Tan(float32(r)) }
+
+func (r Radians) Sincos() (sin, cos float32) { return Sincos(float32(r)) }
+
+// Returns the angle wrapped into the range [-Pi, Pi).
+func (r Radians) Wrap() Radians { return Radians(wrapAngle(float32(r), RadFull)) }
+
+// Returns the angle wrapped into the range [-180, 180).
+func (d Degrees) Wrap() Degrees { return Degrees(wrapAngle(float32(d), DegFull)) }
+
+// Returns the angle wrapped into the range [0, 2*Pi).
+func (r Radians) WrapPositive() Radians { return Radians(wrapAnglePositive(float32(r), RadFull)) }
+
+// Returns the angle wrapped into the range [0, 360).
+func (d Degrees) WrapPositive() Degrees { return Degrees(wrapAnglePositive(float32(d), DegFull)) }
+
+// Returns the signed shortest rotation from this angle to the target angle, in the range [-Pi, Pi).
+func (r Radians) ShortestDiff(target Radians) Radians { return (target - r).Wrap() }
+
+// Returns the signed shortest rotation from this angle to the target angle, in the range [-180, 180).
+func (d Degrees) ShortestDiff(target Degrees) Degrees { return (target - d).Wrap() }
+
+// Interpolates between this angle and the target angle along the shortest way, based on the alpha value in the range [0,1].
+// The result is wrapped into the range [-Pi, Pi).
+func (r Radians) Lerp(target Radians, alpha float32) Radians {
+	return (r + r.ShortestDiff(target)*Radians(alpha)).Wrap()
+}
+
+// Interpolates between this angle and the target angle along the shortest way, based on the alpha value in the range [0,1].
+// The result is wrapped into the range [-180, 180).
+func (d Degrees) Lerp(target Degrees, alpha float32) Degrees {
+	return (d + d.ShortestDiff(target)*Degrees(alpha)).Wrap()
+}
+
+func wrapAngle(angle, full float32) float32 {
+	half := full / 2
+	return angle - full*Floor((angle+half)/full)
+}
+
+func wrapAnglePositive(angle, full float32) float32 {
+	angle -= full * Floor(angle/full)
+	// Rounding can yield exactly full for tiny negative angles.
+	if angle >= full {
+		angle = 0
+	}
+	return angle
+}
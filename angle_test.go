@@ -0,0 +1,52 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type AngleTestSuite struct{}
+
+var _ = Suite(&AngleTestSuite{})
+
+func (s *AngleTestSuite) TestConversion(c *C) {
+	c.Check(float32(Degrees(180).Radians()), EqualsFloat32, Pi)
+	c.Check(float32(Radians(Pi/2).Degrees()), EqualsFloat32, float32(90))
+	c.Check(Radians(1).Radians(), Equals, Radians(1))
+	c.Check(Degrees(1).Degrees(), Equals, Degrees(1))
+}
+
+func (s *AngleTestSuite) TestWrap(c *C) {
+	c.Check(float32(Degrees(190).Wrap()), EqualsFloat32, float32(-170))
+	c.Check(float32(Degrees(-190).Wrap()), EqualsFloat32, float32(170))
+	c.Check(float32(Degrees(720+45).Wrap()), EqualsFloat32, float32(45))
+	c.Check(float32(Degrees(180).Wrap()), EqualsFloat32, float32(-180))
+	c.Check(float32(Radians(3*Pi/2).Wrap()), EqualsFloat32, -Pi/2)
+
+	c.Check(float32(Degrees(-90).WrapPositive()), EqualsFloat32, float32(270))
+	c.Check(float32(Degrees(360).WrapPositive()), Equals, float32(0))
+	c.Check(float32(Radians(-Pi/2).WrapPositive()), EqualsFloat32, 3*Pi/2)
+}
+
+func (s *AngleTestSuite) TestShortestDiff(c *C) {
+	c.Check(float32(Degrees(350).ShortestDiff(10)), EqualsFloat32, float32(20))
+	c.Check(float32(Degrees(10).ShortestDiff(350)), EqualsFloat32, float32(-20))
+	c.Check(float32(Radians(-3).ShortestDiff(3)), EqualsFloat32, 6-Pi2)
+}
+
+func (s *AngleTestSuite) TestLerp(c *C) {
+	c.Check(float32(Degrees(350).Lerp(30, 0.25)), EqualsFloat32, float32(0))
+	c.Check(float32(Degrees(350).Lerp(30, 0.5)), EqualsFloat32, float32(10))
+	c.Check(float32(Radians(Pi-0.1).Lerp(Radians(-Pi+0.1), 0.5)), EqualsFloat32, -Pi)
+}
+
+func (s *AngleTestSuite) TestTypedVariants(c *C) {
+	v := Vec2(1, 0).RotateAngle(Radians(Pi / 2))
+	c.Check(Abs(v.X) < 0.00001, Equals, true)
+	c.Check(v.Y, EqualsFloat32, float32(1))
+
+	c.Check(NewRotationMatrix4Angle(Vec3(1, 2, 3), Radians(0.5)), Matrix4Check, NewRotationMatrix4(Vec3(1, 2, 3), 0.5*RadiansToDegrees))
+	c.Check(NewPerspectiveMatrix4Angle(Degrees(60), 1.5, 1, 10), Matrix4Check, NewPerspectiveMatrix4(60, 1.5, 1, 10))
+
+	q := NewQuaternion(0, 0, 0, 1).SetEulerAnglesAngle(Degrees(30), Radians(0.2), Degrees(-45))
+	c.Check(*q, Equals, *NewQuaternion(0, 0, 0, 1).SetEulerAngles(30*DegreeToRadians, 0.2, -45*DegreeToRadians))
+}
@@ -43,7 +43,7 @@ func (box *BoundingBox) Set(Minimum, Maximum Vector3) *BoundingBox {
 }
 
 func (box *BoundingBox) Cpy() *BoundingBox {
-	return NewBoundingBox(box.Min, box.Max)
+	return &BoundingBox{box.Min, box.Max}
 }
 
 func (box *BoundingBox) IsValid() bool {
@@ -67,68 +67,120 @@ func (box *BoundingBox) Dimension() Vector3 {
 	return box.Max.Sub(box.Min)
 }
 
+// Grows the box to the union of both boxes.
 func (box *BoundingBox) Extend(bounds *BoundingBox) *BoundingBox {
-	return box.Set(box.Min.Set(Min(box.Min.X, bounds.Min.X), Min(box.Min.Y, bounds.Min.Y), Min(box.Min.Z, bounds.Min.Z)),
-		box.Max.Set(Max(box.Max.X, bounds.Max.X), Max(box.Max.Y, bounds.Max.Y), Max(box.Max.Z, bounds.Max.Z)))
+	box.Min = box.Min.Min(bounds.Min)
+	box.Max = box.Max.Max(bounds.Max)
+	return box
 }
 
+// Grows the box to contain the point.
 func (box *BoundingBox) ExtendByVec(v Vector3) *BoundingBox {
-	return box.Set(box.Min.Set(Min(box.Min.X, v.X), Min(box.Min.Y, v.Y), Min(box.Min.Z, v.Z)), box.Max.Set(Max(box.Max.X, v.X), Max(box.Max.Y, v.Y), Max(box.Max.Z, v.Z)))
+	box.Min = box.Min.Min(v)
+	box.Max = box.Max.Max(v)
+	return box
+}
+
+// Shrinks the box to the intersection of both boxes, which is empty if they don't overlap.
+func (box *BoundingBox) Intersect(bounds *BoundingBox) *BoundingBox {
+	box.Min = box.Min.Max(bounds.Min)
+	box.Max = box.Max.Min(bounds.Max)
+	return box
 }
 
+// Returns whether the box and the other box have at least one point in common.
+func (box *BoundingBox) Overlaps(bounds *BoundingBox) bool {
+	return box.Min.X <= bounds.Max.X && box.Max.X >= bounds.Min.X &&
+		box.Min.Y <= bounds.Max.Y && box.Max.Y >= bounds.Min.Y &&
+		box.Min.Z <= bounds.Max.Z && box.Max.Z >= bounds.Min.Z
+}
+
+// Returns whether the other box is completely inside of the box. An empty box is inside of every box.
 func (box *BoundingBox) Contains(bounds *BoundingBox) bool {
-	if !box.IsValid() {
-		return true
-	}
-	if box.Min.X > bounds.Min.X {
-		return true
-	}
-	if box.Min.Y > bounds.Min.Y {
-		return true
-	}
-	if box.Min.Z > bounds.Min.Z {
-		return true
-	}
-	if box.Max.X < bounds.Max.X {
-		return true
-	}
-	if box.Max.Y < bounds.Max.Y {
-		return true
-	}
-	if box.Max.Z < bounds.Max.Z {
-		return true
-	}
-	return true
+	return bounds.IsEmpty() ||
+		box.Min.X <= bounds.Min.X && box.Min.Y <= bounds.Min.Y && box.Min.Z <= bounds.Min.Z &&
+			box.Max.X >= bounds.Max.X && box.Max.Y >= bounds.Max.Y && box.Max.Z >= bounds.Max.Z
 }
 
+// Returns whether the point is inside of the box or on its surface.
 func (box *BoundingBox) ContainsVec(v Vector3) bool {
-	if box.Min.X > v.X {
-		return true
-	}
-	if box.Max.X < v.X {
-		return true
-	}
-	if box.Min.Y > v.Y {
-		return true
-	}
-	if box.Max.Y < v.Y {
-		return true
-	}
-	if box.Min.Z > v.Z {
-		return true
+	return box.Min.X <= v.X && box.Max.X >= v.X &&
+		box.Min.Y <= v.Y && box.Max.Y >= v.Y &&
+		box.Min.Z <= v.Z && box.Max.Z >= v.Z
+}
+
+// Returns the point of the box nearest to the point, the point itself if it is inside.
+func (box *BoundingBox) ClosestPoint(v Vector3) Vector3 {
+	return v.Max(box.Min).Min(box.Max)
+}
+
+func (box *BoundingBox) Center() Vector3 {
+	return box.Min.Add(box.Max).Scale(0.5)
+}
+
+// Returns the half of the dimension, the distance from the center to the faces along each axis.
+func (box *BoundingBox) Extents() Vector3 {
+	return box.Max.Sub(box.Min).Scale(0.5)
+}
+
+// Returns the surface area of the box, 0 if it is empty.
+func (box *BoundingBox) SurfaceArea() float32 {
+	if box.IsEmpty() {
+		return 0
 	}
-	if box.Max.Z < v.Z {
-		return true
+	d := box.Max.Sub(box.Min)
+	return 2 * (d.X*d.Y + d.Y*d.Z + d.Z*d.X)
+}
+
+// Returns the volume of the box, 0 if it is empty.
+func (box *BoundingBox) Volume() float32 {
+	if box.IsEmpty() {
+		return 0
 	}
-	return true
+	d := box.Max.Sub(box.Min)
+	return d.X * d.Y * d.Z
+}
+
+// Sets the box to the smallest axis aligned box around the box transformed by the affine matrix,
+// using the method of Arvo from Graphics Gems. An empty box stays empty.
+func (box *BoundingBox) Transform(m *Matrix4) *BoundingBox {
+	if box.IsEmpty() {
+		return box
+	}
+	// Each component of the result is the translation plus the extremes of the contributions of all axes.
+	min, max := box.Min.ToArray(), box.Max.ToArray()
+	rows := [3][4]float32{
+		{m.M11, m.M21, m.M31, m.M41},
+		{m.M12, m.M22, m.M32, m.M42},
+		{m.M13, m.M23, m.M33, m.M43},
+	}
+	var newMin, newMax [3]float32
+	for i, row := range rows {
+		newMin[i], newMax[i] = row[3], row[3]
+		for j := 0; j < 3; j++ {
+			a, b := row[j]*min[j], row[j]*max[j]
+			newMin[i] += Min(a, b)
+			newMax[i] += Max(a, b)
+		}
+	}
+	box.Min = Vec3(newMin[0], newMin[1], newMin[2])
+	box.Max = Vec3(newMax[0], newMax[1], newMax[2])
+	return box
 }
 
+// Sets the box to the empty state with the minimum at MaxFloat32 and the maximum at -MaxFloat32, which contains
+// no point and becomes the bounds of the first box or point it is extended by.
 func (box *BoundingBox) Inf() *BoundingBox {
 	box.Min.Set(math.MaxFloat32, math.MaxFloat32, math.MaxFloat32)
-	box.Max.Set(math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32)
+	box.Max.Set(-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32)
 	return box
 }
 
+// Returns whether the box contains no point, which is the case when its minimum exceeds its maximum on any axis.
+func (box *BoundingBox) IsEmpty() bool {
+	return box.Min.X > box.Max.X || box.Min.Y > box.Max.Y || box.Min.Z > box.Max.Z
+}
+
 func (box *BoundingBox) Clr() *BoundingBox {
 	box.Min = box.Min.Clr()
 	box.Max = box.Max.Clr()
@@ -130,6 +130,7 @@ func (s *BoundingBoxTestSuite) TestCpy(c *C) {
 	bb := NewBoundingBox(Vec3(-1, 2, -3), Vec3(1, 0.2, 3.3))
 	obtained := bb.Cpy()
 	c.Check(obtained, BoundingBoxCheck, bb)
+	c.Check(new(BoundingBox).Inf().Cpy().IsEmpty(), Equals, true)
 }
 
 func (s *BoundingBoxTestSuite) TestIsValid(c *C) {
@@ -152,3 +153,82 @@ func (s *BoundingBoxTestSuite) TestDimension(c *C) {
 		c.Check(obtained, DeepEquals, value.Expected)
 	}
 }
+
+func (s *BoundingBoxTestSuite) TestContains(c *C) {
+	box := NewBoundingBox(Vec3(0, 0, 0), Vec3(2, 2, 2))
+	c.Check(box.ContainsVec(Vec3(1, 1, 1)), Equals, true)
+	c.Check(box.ContainsVec(Vec3(2, 0, 1)), Equals, true)
+	c.Check(box.ContainsVec(Vec3(3, 1, 1)), Equals, false)
+	c.Check(box.ContainsVec(Vec3(1, -1, 1)), Equals, false)
+	c.Check(box.ContainsVec(Vec3(1, 1, 2.1)), Equals, false)
+
+	c.Check(box.Contains(NewBoundingBox(Vec3(0.5, 0, 1), Vec3(2, 1, 1.5))), Equals, true)
+	c.Check(box.Contains(box), Equals, true)
+	c.Check(box.Contains(NewBoundingBox(Vec3(0.5, 0, 1), Vec3(2, 2.5, 1.5))), Equals, false)
+	c.Check(box.Contains(NewBoundingBox(Vec3(-1, -1, -1), Vec3(3, 3, 3))), Equals, false)
+	c.Check(box.Contains(new(BoundingBox).Inf()), Equals, true)
+	c.Check(new(BoundingBox).Inf().Contains(box), Equals, false)
+
+	c.Check(box.Overlaps(NewBoundingBox(Vec3(2, 2, 2), Vec3(3, 3, 3))), Equals, true)
+	c.Check(box.Overlaps(NewBoundingBox(Vec3(2, 2.1, 2), Vec3(3, 3, 3))), Equals, false)
+	c.Check(box.Overlaps(new(BoundingBox).Inf()), Equals, false)
+}
+
+func (s *BoundingBoxTestSuite) TestEmpty(c *C) {
+	box := new(BoundingBox).Inf()
+	c.Check(*box, Equals, BoundingBox{Min: Vec3(MaxFloat32, MaxFloat32, MaxFloat32), Max: Vec3(-MaxFloat32, -MaxFloat32, -MaxFloat32)})
+	c.Check(box.IsEmpty(), Equals, true)
+	c.Check(box.IsValid(), Equals, false)
+	c.Check(box.ContainsVec(Vec3(0, 0, 0)), Equals, false)
+	c.Check(box.Volume(), Equals, float32(0))
+	c.Check(box.SurfaceArea(), Equals, float32(0))
+	c.Check(box.Extend(new(BoundingBox).Inf()).IsEmpty(), Equals, true)
+
+	c.Check(*box.ExtendByVec(Vec3(1, 2, 3)), Equals, BoundingBox{Min: Vec3(1, 2, 3), Max: Vec3(1, 2, 3)})
+	c.Check(box.IsEmpty(), Equals, false)
+	c.Check(*box.ExtendByVec(Vec3(-1, 4, 3)), Equals, BoundingBox{Min: Vec3(-1, 2, 3), Max: Vec3(1, 4, 3)})
+	c.Check(*new(BoundingBox).Inf().Extend(box), Equals, *box)
+}
+
+func (s *BoundingBoxTestSuite) TestUnionAndIntersection(c *C) {
+	box := NewBoundingBox(Vec3(0, 0, 0), Vec3(2, 2, 2))
+	c.Check(*box.Cpy().Extend(NewBoundingBox(Vec3(1, -1, 1), Vec3(3, 1, 1.5))), Equals, BoundingBox{Min: Vec3(0, -1, 0), Max: Vec3(3, 2, 2)})
+	c.Check(*box.Cpy().Intersect(NewBoundingBox(Vec3(1, -1, 1), Vec3(3, 1, 1.5))), Equals, BoundingBox{Min: Vec3(1, 0, 1), Max: Vec3(2, 1, 1.5)})
+	c.Check(box.Cpy().Intersect(NewBoundingBox(Vec3(3, 0, 0), Vec3(4, 1, 1))).IsEmpty(), Equals, true)
+}
+
+func (s *BoundingBoxTestSuite) TestMeasures(c *C) {
+	box := NewBoundingBox(Vec3(-1, 0, 1), Vec3(1, 4, 4))
+	c.Check(box.Center(), Equals, Vec3(0, 2, 2.5))
+	c.Check(box.Extents(), Equals, Vec3(1, 2, 1.5))
+	c.Check(box.Volume(), Equals, float32(24))
+	c.Check(box.SurfaceArea(), Equals, float32(52))
+	c.Check(box.ClosestPoint(Vec3(0, 1, 2)), Equals, Vec3(0, 1, 2))
+	c.Check(box.ClosestPoint(Vec3(-3, 1, 5)), Equals, Vec3(-1, 1, 4))
+	c.Check(box.ClosestPoint(Vec3(2, -2, 0)), Equals, Vec3(1, 0, 1))
+}
+
+func (s *BoundingBoxTestSuite) TestTransform(c *C) {
+	box := NewBoundingBox(Vec3(-1, -2, -3), Vec3(1, 2, 3))
+	c.Check(*box.Cpy().Transform(NewIdentityMatrix4()), Equals, *box)
+
+	var rotation Quaternion
+	rotation.SetFromAxis(0, 0, 1, Pi/2)
+	m := NewTranslationMatrix4(10, 0, 0).Mul(rotation.Matrix()).Scale(Vec3(2, 1, 1))
+	transformed := box.Cpy().Transform(m)
+	c.Check(transformed.Min, Vector3Check, Vec3(8, -2, -3))
+	c.Check(transformed.Max, Vector3Check, Vec3(12, 2, 3))
+
+	// The result is the bounds of the transformed corners.
+	rotation.SetFromAxis(1, 2, 3, 0.7)
+	m = NewTranslationMatrix4(1, -2, 5).Mul(rotation.Matrix()).Scale(Vec3(1, 3, 0.5))
+	expected := new(BoundingBox).Inf()
+	for _, corner := range box.Corners() {
+		expected.ExtendByVec(m.MulVec3(corner))
+	}
+	transformed = box.Cpy().Transform(m)
+	c.Check(transformed.Min, Vector3Check, expected.Min)
+	c.Check(transformed.Max, Vector3Check, expected.Max)
+
+	c.Check(new(BoundingBox).Inf().Transform(m).IsEmpty(), Equals, true)
+}
@@ -0,0 +1,385 @@
+package math
+
+import (
+	"sort"
+)
+
+// The strategy used to split the items of a BVH node.
+type BVHSplit int
+
+const (
+	// Binned surface area heuristic, slower to build but faster to query.
+	BVHSplit_SAH BVHSplit = iota
+	// Splits at the median of the item centers along the longest axis.
+	BVHSplit_Median
+)
+
+const (
+	// The default maximum number of items in a leaf.
+	DefaultBVHLeafSize = 4
+	bvhBins            = 16
+)
+
+// A node of a BVH. The nodes are stored depth-first in a single slice:
+// the left child of an inner node directly follows it, the right child is at Offset.
+type BVHNode struct {
+	Bounds BoundingBox
+	// The index of the right child for inner nodes, the index of the first item in BVH.Items for leaves.
+	Offset int
+	// The number of items of a leaf, 0 for inner nodes.
+	Count int
+}
+
+func (n *BVHNode) IsLeaf() bool {
+	return n.Count > 0
+}
+
+// A bounding volume hierarchy over items with BoundingBox bounds.
+// Items are identified by their index in the bounds given to NewBVH.
+type BVH struct {
+	Nodes []BVHNode
+	// The item indices in leaf order, a leaf refers to Items[Offset:Offset+Count].
+	Items  []int
+	bounds []BoundingBox
+}
+
+// Builds a BVH over the bounds with at most DefaultBVHLeafSize items per leaf.
+func NewBVH(bounds []BoundingBox, split BVHSplit) *BVH {
+	return NewBVHWithLeafSize(bounds, split, DefaultBVHLeafSize)
+}
+
+// Builds a BVH over the bounds with at most maxLeafSize items per leaf.
+func NewBVHWithLeafSize(bounds []BoundingBox, split BVHSplit, maxLeafSize int) *BVH {
+	if maxLeafSize < 1 {
+		maxLeafSize = 1
+	}
+	bvh := &BVH{
+		Nodes:  make([]BVHNode, 0, 2*len(bounds)),
+		Items:  make([]int, len(bounds)),
+		bounds: make([]BoundingBox, len(bounds)),
+	}
+	copy(bvh.bounds, bounds)
+	if len(bounds) == 0 {
+		return bvh
+	}
+	b := &bvhBuilder{bvh: bvh, split: split, maxLeafSize: maxLeafSize, centers: make([]Vector3, len(bounds))}
+	for i := range bounds {
+		bvh.Items[i] = i
+		b.centers[i] = bounds[i].Min.Add(bounds[i].Max).Scale(0.5)
+	}
+	b.build(0, len(bounds))
+	return bvh
+}
+
+// The number of items
+func (bvh *BVH) Len() int {
+	return len(bvh.bounds)
+}
+
+// The bounds of all items, zero for an empty BVH.
+func (bvh *BVH) Bounds() BoundingBox {
+	if len(bvh.Nodes) == 0 {
+		return BoundingBox{}
+	}
+	return bvh.Nodes[0].Bounds
+}
+
+// Updates the bounds of the items after they moved and refits the nodes without changing the hierarchy.
+// The query performance degrades if the items moved far, rebuild the BVH in that case.
+func (bvh *BVH) Refit(bounds []BoundingBox) error {
+	if len(bounds) != len(bvh.bounds) {
+		return ErrDimensionMismatch
+	}
+	copy(bvh.bounds, bounds)
+	// Children are always stored after their parent.
+	for i := len(bvh.Nodes) - 1; i >= 0; i-- {
+		node := &bvh.Nodes[i]
+		if node.IsLeaf() {
+			node.Bounds = bvh.leafBounds(node)
+		} else {
+			node.Bounds = bvh.Nodes[i+1].Bounds
+			node.Bounds.Extend(&bvh.Nodes[node.Offset].Bounds)
+		}
+	}
+	return nil
+}
+
+func (bvh *BVH) leafBounds(node *BVHNode) BoundingBox {
+	box := bvh.bounds[bvh.Items[node.Offset]]
+	for _, item := range bvh.Items[node.Offset+1 : node.Offset+node.Count] {
+		box.Extend(&bvh.bounds[item])
+	}
+	return box
+}
+
+// Returns the nearest item hit by the ray within maxDistance and the distance in multiples of the ray direction.
+// hit calculates the exact intersection of the ray with an item, if it is nil the bounds of the items are used.
+func (bvh *BVH) RayCast(ray *Ray, maxDistance float32, hit func(item int, ray *Ray) (float32, bool)) (item int, distance float32, ok bool) {
+	if len(bvh.Nodes) == 0 {
+		return -1, 0, false
+	}
+	invDir := Vec3(1/ray.Direction.X, 1/ray.Direction.Y, 1/ray.Direction.Z)
+	item = -1
+	distance = maxDistance
+	stack := make([]int, 1, 64)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := &bvh.Nodes[index]
+		if _, ok := intersectRayBounds(ray.Origin, invDir, &node.Bounds, distance); !ok {
+			continue
+		}
+		if node.IsLeaf() {
+			for _, i := range bvh.Items[node.Offset : node.Offset+node.Count] {
+				if d, ok := bvh.hitItem(i, ray, invDir, distance, hit); ok && d <= distance {
+					item, distance = i, d
+				}
+			}
+			continue
+		}
+		// Visit the nearer child first so that the farther one can be culled.
+		near, far := index+1, node.Offset
+		dNear, okNear := intersectRayBounds(ray.Origin, invDir, &bvh.Nodes[near].Bounds, distance)
+		dFar, okFar := intersectRayBounds(ray.Origin, invDir, &bvh.Nodes[far].Bounds, distance)
+		if okNear && okFar && dFar < dNear {
+			near, far = far, near
+		}
+		if okFar {
+			stack = append(stack, far)
+		}
+		if okNear {
+			stack = append(stack, near)
+		}
+	}
+	return item, distance, item >= 0
+}
+
+// Returns any item hit by the ray within maxDistance, this is faster than RayCast if the nearest hit isn't needed,
+// e.g. for shadow rays. hit calculates the exact intersection of the ray with an item,
+// if it is nil the bounds of the items are used.
+func (bvh *BVH) RayCastAny(ray *Ray, maxDistance float32, hit func(item int, ray *Ray) (float32, bool)) (item int, ok bool) {
+	if len(bvh.Nodes) == 0 {
+		return -1, false
+	}
+	invDir := Vec3(1/ray.Direction.X, 1/ray.Direction.Y, 1/ray.Direction.Z)
+	stack := make([]int, 1, 64)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := &bvh.Nodes[index]
+		if _, ok := intersectRayBounds(ray.Origin, invDir, &node.Bounds, maxDistance); !ok {
+			continue
+		}
+		if node.IsLeaf() {
+			for _, i := range bvh.Items[node.Offset : node.Offset+node.Count] {
+				if d, ok := bvh.hitItem(i, ray, invDir, maxDistance, hit); ok && d <= maxDistance {
+					return i, true
+				}
+			}
+			continue
+		}
+		stack = append(stack, node.Offset, index+1)
+	}
+	return -1, false
+}
+
+func (bvh *BVH) hitItem(item int, ray *Ray, invDir Vector3, maxDistance float32, hit func(int, *Ray) (float32, bool)) (float32, bool) {
+	if hit != nil {
+		return hit(item, ray)
+	}
+	return intersectRayBounds(ray.Origin, invDir, &bvh.bounds[item], maxDistance)
+}
+
+// Appends the items whose bounds overlap the box to result.
+func (bvh *BVH) QueryBounds(box *BoundingBox, result []int) []int {
+	return bvh.query(func(bounds *BoundingBox) bool { return bounds.Overlaps(box) }, result)
+}
+
+// Appends the items whose bounds overlap the sphere to result.
+func (bvh *BVH) QuerySphere(sphere *Sphere, result []int) []int {
+	return bvh.query(func(bounds *BoundingBox) bool { return IntersectSphereBounds(sphere, bounds) }, result)
+}
+
+// Appends the items whose bounds are at least partially in the frustum to result.
+// Like Frustum.BoundsInFrustum the test is conservative, items close to the frustum may be reported as well.
+func (bvh *BVH) QueryFrustum(frustum *Frustum, result []int) []int {
+	return bvh.query(frustum.BoundsInFrustum, result)
+}
+
+func (bvh *BVH) query(overlaps func(bounds *BoundingBox) bool, result []int) []int {
+	if len(bvh.Nodes) == 0 {
+		return result
+	}
+	stack := make([]int, 1, 64)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := &bvh.Nodes[index]
+		if !overlaps(&node.Bounds) {
+			continue
+		}
+		if node.IsLeaf() {
+			for _, item := range bvh.Items[node.Offset : node.Offset+node.Count] {
+				if overlaps(&bvh.bounds[item]) {
+					result = append(result, item)
+				}
+			}
+			continue
+		}
+		stack = append(stack, node.Offset, index+1)
+	}
+	return result
+}
+
+type bvhBuilder struct {
+	bvh         *BVH
+	split       BVHSplit
+	maxLeafSize int
+	centers     []Vector3
+}
+
+type bvhBin struct {
+	bounds BoundingBox
+	count  int
+}
+
+// Builds the subtree over Items[start:end] and returns the index of its root node.
+func (b *bvhBuilder) build(start, end int) int {
+	bvh := b.bvh
+	index := len(bvh.Nodes)
+	bvh.Nodes = append(bvh.Nodes, BVHNode{Offset: start, Count: end - start})
+	bvh.Nodes[index].Bounds = bvh.leafBounds(&bvh.Nodes[index])
+	count := end - start
+	if count == 1 {
+		return index
+	}
+
+	centerBounds := BoundingBox{Min: b.centers[bvh.Items[start]], Max: b.centers[bvh.Items[start]]}
+	for _, item := range bvh.Items[start+1 : end] {
+		centerBounds.Min = centerBounds.Min.Min(b.centers[item])
+		centerBounds.Max = centerBounds.Max.Max(b.centers[item])
+	}
+	extent := centerBounds.Max.Sub(centerBounds.Min)
+	axis := 0
+	if extent.Y > extent.Get(axis) {
+		axis = 1
+	}
+	if extent.Z > extent.Get(axis) {
+		axis = 2
+	}
+
+	mid := -1
+	if extent.Get(axis) > 0 {
+		if b.split == BVHSplit_SAH {
+			mid = b.splitSAH(start, end, &bvh.Nodes[index].Bounds, &centerBounds)
+		} else if count > b.maxLeafSize {
+			// The median split doesn't estimate costs, so it only splits leaves which are too large.
+			mid = b.splitMedian(start, end, axis)
+		}
+	}
+	if mid < 0 {
+		if count <= b.maxLeafSize {
+			return index
+		}
+		// All centers are equal or splitting isn't worth it, but the leaf would be too large.
+		mid = (start + end) / 2
+	}
+
+	bvh.Nodes[index].Count = 0
+	b.build(start, mid)
+	right := b.build(mid, end)
+	bvh.Nodes[index].Offset = right
+	return index
+}
+
+func (b *bvhBuilder) splitMedian(start, end, axis int) int {
+	items := b.bvh.Items[start:end]
+	sort.Slice(items, func(i, j int) bool {
+		return b.centers[items[i]].Get(axis) < b.centers[items[j]].Get(axis)
+	})
+	return (start + end) / 2
+}
+
+// Returns the split position with the lowest surface area heuristic cost over all axes
+// or -1 if a leaf is cheaper and allowed.
+func (b *bvhBuilder) splitSAH(start, end int, bounds, centerBounds *BoundingBox) int {
+	items := b.bvh.Items[start:end]
+	extent := centerBounds.Max.Sub(centerBounds.Min)
+	bestCost := float32(MaxFloat32)
+	bestAxis, bestBin := -1, 0
+	for axis := 0; axis < 3; axis++ {
+		if extent.Get(axis) <= 0 {
+			continue
+		}
+		var bins [bvhBins]bvhBin
+		for _, item := range items {
+			bin := &bins[b.binOf(item, axis, centerBounds)]
+			if bin.count == 0 {
+				bin.bounds = b.bvh.bounds[item]
+			} else {
+				bin.bounds.Extend(&b.bvh.bounds[item])
+			}
+			bin.count++
+		}
+
+		// Sweep from the right to get the cost of all bins right of each split plane.
+		var rightArea [bvhBins]float32
+		var rightCount [bvhBins]int
+		var acc bvhBin
+		for i := bvhBins - 1; i > 0; i-- {
+			acc = accumulateBin(acc, bins[i])
+			rightArea[i] = acc.bounds.SurfaceArea()
+			rightCount[i] = acc.count
+		}
+		acc = bvhBin{}
+		for i := 0; i < bvhBins-1; i++ {
+			acc = accumulateBin(acc, bins[i])
+			if acc.count == 0 || rightCount[i+1] == 0 {
+				continue
+			}
+			cost := acc.bounds.SurfaceArea()*float32(acc.count) + rightArea[i+1]*float32(rightCount[i+1])
+			if cost < bestCost {
+				bestCost, bestAxis, bestBin = cost, axis, i
+			}
+		}
+	}
+	if bestAxis < 0 {
+		return -1
+	}
+
+	// Compare with the cost of a leaf, the cost of traversing a node is about the cost of testing an item.
+	area := bounds.SurfaceArea()
+	if len(items) <= b.maxLeafSize && area > 0 && 1+bestCost/area >= float32(len(items)) {
+		return -1
+	}
+
+	// Partition the items left and right of the split plane.
+	i, j := 0, len(items)-1
+	for i <= j {
+		if b.binOf(items[i], bestAxis, centerBounds) <= bestBin {
+			i++
+		} else {
+			items[i], items[j] = items[j], items[i]
+			j--
+		}
+	}
+	return start + i
+}
+
+func (b *bvhBuilder) binOf(item, axis int, centerBounds *BoundingBox) int {
+	min := centerBounds.Min.Get(axis)
+	bin := int(float32(bvhBins) * (b.centers[item].Get(axis) - min) / (centerBounds.Max.Get(axis) - min))
+	return Clampi(bin, 0, bvhBins-1)
+}
+
+func accumulateBin(acc, bin bvhBin) bvhBin {
+	if bin.count == 0 {
+		return acc
+	}
+	if acc.count == 0 {
+		return bin
+	}
+	acc.bounds.Extend(&bin.bounds)
+	acc.count += bin.count
+	return acc
+}
@@ -0,0 +1,204 @@
+package math
+
+import (
+	"math/rand"
+	"sort"
+
+	. "launchpad.net/gocheck"
+)
+
+type BVHTestSuite struct {
+	bounds []BoundingBox
+}
+
+var _ = Suite(&BVHTestSuite{})
+
+func randomBounds(rnd *rand.Rand, n int, size float32) []BoundingBox {
+	bounds := make([]BoundingBox, n)
+	for i := range bounds {
+		min := Vec3(rnd.Float32()*100, rnd.Float32()*100, rnd.Float32()*100)
+		ext := Vec3(rnd.Float32()*size, rnd.Float32()*size, rnd.Float32()*size)
+		bounds[i] = BoundingBox{Min: min, Max: min.Add(ext)}
+	}
+	return bounds
+}
+
+func (s *BVHTestSuite) SetUpTest(c *C) {
+	s.bounds = randomBounds(rand.New(rand.NewSource(1)), 500, 5)
+}
+
+func sortedInts(values []int) []int {
+	sort.Ints(values)
+	return values
+}
+
+// Checks the structure of the flat node layout.
+func checkBVH(c *C, bvh *BVH, n int) {
+	seen := make([]bool, n)
+	for i := range bvh.Nodes {
+		node := &bvh.Nodes[i]
+		if node.IsLeaf() {
+			for _, item := range bvh.Items[node.Offset : node.Offset+node.Count] {
+				c.Assert(seen[item], Equals, false)
+				seen[item] = true
+				c.Assert(node.Bounds.Overlaps(&bvh.bounds[item]), Equals, true)
+			}
+			continue
+		}
+		c.Assert(node.Offset > i+1, Equals, true)
+		c.Assert(*bvh.Nodes[i+1].Bounds.Cpy().Extend(&bvh.Nodes[node.Offset].Bounds), Equals, node.Bounds)
+	}
+	for _, ok := range seen {
+		c.Assert(ok, Equals, true)
+	}
+}
+
+func (s *BVHTestSuite) TestBuild(c *C) {
+	for _, split := range []BVHSplit{BVHSplit_SAH, BVHSplit_Median} {
+		bvh := NewBVH(s.bounds, split)
+		c.Check(bvh.Len(), Equals, len(s.bounds))
+		checkBVH(c, bvh, len(s.bounds))
+		for _, node := range bvh.Nodes {
+			c.Check(node.Count <= DefaultBVHLeafSize, Equals, true)
+		}
+	}
+
+	// The median split fills the leaves up to the leaf size.
+	bvh := NewBVHWithLeafSize(s.bounds[:64], BVHSplit_Median, 8)
+	checkBVH(c, bvh, 64)
+	largest := 0
+	for _, node := range bvh.Nodes {
+		largest = maxi(largest, node.Count)
+	}
+	c.Check(largest > 1, Equals, true)
+	c.Check(largest <= 8, Equals, true)
+
+	// Identical bounds can't be split but the leaf size is still respected.
+	same := make([]BoundingBox, 10)
+	for i := range same {
+		same[i] = BoundingBox{Min: Vec3(0, 0, 0), Max: Vec3(1, 1, 1)}
+	}
+	bvh = NewBVHWithLeafSize(same, BVHSplit_SAH, 3)
+	checkBVH(c, bvh, len(same))
+	for _, node := range bvh.Nodes {
+		c.Check(node.Count <= 3, Equals, true)
+	}
+
+	c.Check(len(NewBVH(nil, BVHSplit_SAH).QueryBounds(&same[0], nil)), Equals, 0)
+}
+
+func (s *BVHTestSuite) TestQueryBounds(c *C) {
+	query := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	var expected []int
+	for i := range s.bounds {
+		if s.bounds[i].Overlaps(query) {
+			expected = append(expected, i)
+		}
+	}
+	c.Assert(len(expected) > 0, Equals, true)
+	for _, split := range []BVHSplit{BVHSplit_SAH, BVHSplit_Median} {
+		bvh := NewBVH(s.bounds, split)
+		c.Check(sortedInts(bvh.QueryBounds(query, nil)), DeepEquals, expected)
+	}
+}
+
+func (s *BVHTestSuite) TestQuerySphere(c *C) {
+	sphere := NewSphere(Vec3(50, 50, 50), 15)
+	var expected []int
+	for i := range s.bounds {
+		if IntersectSphereBounds(sphere, &s.bounds[i]) {
+			expected = append(expected, i)
+		}
+	}
+	c.Assert(len(expected) > 0, Equals, true)
+	bvh := NewBVH(s.bounds, BVHSplit_SAH)
+	c.Check(sortedInts(bvh.QuerySphere(sphere, nil)), DeepEquals, expected)
+}
+
+func (s *BVHTestSuite) TestQueryFrustum(c *C) {
+	projection := NewPerspectiveMatrix4(60, 1, 1, 40)
+	view := NewLookAtMatrix4(Vec3(50, 50, -10), Vec3(50, 50, 50), Vec3(0, 1, 0))
+	inv, err := projection.Mul(view).Inverse()
+	c.Assert(err, IsNil)
+	frustum := NewFrustum()
+	frustum.Update(inv)
+	c.Check(frustum.PointInFrustum(Vec3(50, 50, 10)), Equals, true)
+	c.Check(frustum.PointInFrustum(Vec3(50, 50, 40)), Equals, false)
+	c.Check(frustum.PointInFrustum(Vec3(80, 50, 10)), Equals, false)
+
+	var expected []int
+	for i := range s.bounds {
+		if frustum.BoundsInFrustum(&s.bounds[i]) {
+			expected = append(expected, i)
+		}
+	}
+	c.Assert(len(expected) > 0, Equals, true)
+	c.Assert(len(expected) < len(s.bounds), Equals, true)
+	bvh := NewBVH(s.bounds, BVHSplit_SAH)
+	c.Check(sortedInts(bvh.QueryFrustum(frustum, nil)), DeepEquals, expected)
+}
+
+func (s *BVHTestSuite) TestRayCast(c *C) {
+	rnd := rand.New(rand.NewSource(2))
+	for _, split := range []BVHSplit{BVHSplit_SAH, BVHSplit_Median} {
+		bvh := NewBVH(s.bounds, split)
+		for i := 0; i < 50; i++ {
+			ray := NewRay(Vec3(-10, rnd.Float32()*100, rnd.Float32()*100), Vec3(1, rnd.Float32()-0.5, rnd.Float32()-0.5))
+			nearest, nearestDistance := -1, float32(MaxFloat32)
+			for j := range s.bounds {
+				if d, ok := IntersectRayBounds(ray, &s.bounds[j]); ok && d < nearestDistance {
+					nearest, nearestDistance = j, d
+				}
+			}
+			item, distance, ok := bvh.RayCast(ray, MaxFloat32, nil)
+			c.Check(ok, Equals, nearest >= 0)
+			if ok {
+				c.Check(distance, Equals, nearestDistance)
+				c.Check(item, Equals, nearest)
+			}
+			_, okAny := bvh.RayCastAny(ray, MaxFloat32, nil)
+			c.Check(okAny, Equals, ok)
+			if ok {
+				_, okAny = bvh.RayCastAny(ray, nearestDistance*0.99, nil)
+				c.Check(okAny, Equals, false)
+			}
+		}
+	}
+}
+
+func (s *BVHTestSuite) TestRayCastCallback(c *C) {
+	bvh := NewBVH(s.bounds, BVHSplit_SAH)
+	// Only item 7 is hit, at a distance beyond its bounds.
+	hit := func(item int, ray *Ray) (float32, bool) {
+		return 1000 + float32(item), item == 7
+	}
+	center := s.bounds[7].Min.Add(s.bounds[7].Max).Scale(0.5)
+	ray := NewRay(Vec3(center.X, center.Y, -10), Vec3(0, 0, 1))
+	item, distance, ok := bvh.RayCast(ray, MaxFloat32, hit)
+	c.Check(ok, Equals, true)
+	c.Check(item, Equals, 7)
+	c.Check(distance, Equals, float32(1007))
+	_, _, ok = bvh.RayCast(ray, 100, hit)
+	c.Check(ok, Equals, false)
+}
+
+func (s *BVHTestSuite) TestRefit(c *C) {
+	bvh := NewBVH(s.bounds, BVHSplit_SAH)
+	moved := make([]BoundingBox, len(s.bounds))
+	for i, b := range s.bounds {
+		offset := Vec3(float32(i%7), -float32(i%5), 3)
+		moved[i] = BoundingBox{Min: b.Min.Add(offset), Max: b.Max.Add(offset)}
+	}
+	c.Assert(bvh.Refit(moved), IsNil)
+	checkBVH(c, bvh, len(moved))
+
+	query := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	var expected []int
+	for i := range moved {
+		if moved[i].Overlaps(query) {
+			expected = append(expected, i)
+		}
+	}
+	c.Check(sortedInts(bvh.QueryBounds(query, nil)), DeepEquals, expected)
+	c.Check(bvh.Refit(moved[1:]), Equals, ErrDimensionMismatch)
+}
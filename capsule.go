@@ -0,0 +1,96 @@
+package math
+
+// A capsule is the set of points within Radius of the Segment, a cylinder with hemispherical caps.
+type Capsule struct {
+	Segment Segment
+	Radius  float32
+}
+
+func NewCapsule(a, b Vector3, radius float32) *Capsule {
+	return &Capsule{Segment{a, b}, radius}
+}
+
+func (c *Capsule) Volume() float32 {
+	height := c.Segment.B.Distance(c.Segment.A)
+	return Pi*c.Radius*c.Radius*height + 4.0/3.0*Pi*c.Radius*c.Radius*c.Radius
+}
+
+// Returns the smallest axis aligned box around the capsule.
+func (c *Capsule) Bounds() BoundingBox {
+	r := Vec3(c.Radius, c.Radius, c.Radius)
+	return BoundingBox{Min: c.Segment.A.Min(c.Segment.B).Sub(r), Max: c.Segment.A.Max(c.Segment.B).Add(r)}
+}
+
+// Returns whether the point is inside of the capsule or on its surface.
+func (c *Capsule) ContainsVec(point Vector3) bool {
+	return c.Segment.ClosestPoint(point).Distance2(point) <= c.Radius*c.Radius
+}
+
+// Returns the point of the capsule nearest to the point, the point itself if it is inside.
+func (c *Capsule) ClosestPoint(point Vector3) Vector3 {
+	axis := c.Segment.ClosestPoint(point)
+	d := point.Sub(axis)
+	if d.Len2() <= c.Radius*c.Radius {
+		return point
+	}
+	return axis.Add(d.Nor().Scale(c.Radius))
+}
+
+// Returns the point of the capsule farthest in the direction.
+func (c *Capsule) Support(direction Vector3) Vector3 {
+	support := c.Segment.A
+	if c.Segment.B.Dot(direction) > support.Dot(direction) {
+		support = c.Segment.B
+	}
+	return support.Add(direction.Nor().Scale(c.Radius))
+}
+
+// Returns whether the ray hits the capsule and the distance of the hit in multiples of the ray direction.
+// The distance is 0 if the ray starts inside of the capsule.
+func (c *Capsule) IntersectRay(ray *Ray) (float32, bool) {
+	if c.ContainsVec(ray.Origin) {
+		return 0, true
+	}
+	// The capsule is the union of a cylinder and two spheres, the ray enters it where it enters the first of them.
+	distance, ok := intersectRayCylinderSide(ray, c.Segment.A, c.Segment.B, c.Radius)
+	for _, center := range [2]Vector3{c.Segment.A, c.Segment.B} {
+		if d, hit := intersectRaySphere(ray, center, c.Radius); hit && (!ok || d < distance) {
+			distance, ok = d, true
+		}
+	}
+	return distance, ok
+}
+
+// Returns where the ray from outside enters the sphere.
+func intersectRaySphere(ray *Ray, center Vector3, radius float32) (float32, bool) {
+	m := ray.Origin.Sub(center)
+	a := ray.Direction.Dot(ray.Direction)
+	b := m.Dot(ray.Direction)
+	c := m.Dot(m) - radius*radius
+	discriminant := b*b - a*c
+	if a == 0 || discriminant < 0 {
+		return 0, false
+	}
+	t := (-b - Sqrt(discriminant)) / a
+	return t, t >= 0
+}
+
+// Returns where the ray from outside of the infinite cylinder around the line through a and b
+// enters it between a and b.
+func intersectRayCylinderSide(ray *Ray, a, b Vector3, radius float32) (float32, bool) {
+	d, m, n := b.Sub(a), ray.Origin.Sub(a), ray.Direction
+	md, nd, dd := m.Dot(d), n.Dot(d), d.Dot(d)
+	qa := dd*n.Dot(n) - nd*nd
+	qb := dd*m.Dot(n) - nd*md
+	qc := dd*(m.Dot(m)-radius*radius) - md*md
+	discriminant := qb*qb - qa*qc
+	if qa == 0 || qc <= 0 || discriminant < 0 {
+		return 0, false
+	}
+	t := (-qb - Sqrt(discriminant)) / qa
+	if t < 0 {
+		return 0, false
+	}
+	s := md + t*nd
+	return t, s >= 0 && s <= dd
+}
@@ -0,0 +1,27 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type CapsuleTestSuite struct{}
+
+var _ = Suite(&CapsuleTestSuite{})
+
+func (s *CapsuleTestSuite) TestCapsule(c *C) {
+	capsule := NewCapsule(Vec3(0, 0, 0), Vec3(0, 2, 0), 1)
+	c.Check(capsule.Volume(), EqualsFloat32, 2*Pi+4.0/3.0*Pi)
+	c.Check(capsule.Bounds(), Equals, BoundingBox{Min: Vec3(-1, -1, -1), Max: Vec3(1, 3, 1)})
+	c.Check(capsule.ContainsVec(Vec3(0, 2.9, 0)), Equals, true)
+	c.Check(capsule.ContainsVec(Vec3(0.9, 2.9, 0)), Equals, false)
+	c.Check(capsule.ClosestPoint(Vec3(3, 1, 0)), Equals, Vec3(1, 1, 0))
+	c.Check(capsule.Support(Vec3(1, 1, 0)), Vector3Check, Vec3(Sqrt(0.5), 2+Sqrt(0.5), 0))
+	distance, ok := capsule.IntersectRay(NewRay(Vec3(0, 5, 0), Vec3(0, -1, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(2))
+	distance, ok = capsule.IntersectRay(NewRay(Vec3(-5, 1, 0), Vec3(2, 0, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(2))
+
+	checkSolid(c, NewCapsule(Vec3(1, -2, 0.5), Vec3(-1, 3, 2), 0.8), 1)
+}
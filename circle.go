@@ -23,3 +23,66 @@ func (c *Circle) Set(x, y, radius float32) Circle {
 	c.Radius = radius
 	return *c
 }
+
+// Returns the smallest circle containing all points using Welzl's algorithm, with the move-to-front
+// heuristic of Gärtner instead of a random permutation of the points. The circle has a radius of 0 for no points.
+func NewMinimalCircle(points []Vector2) *Circle {
+	if len(points) == 0 {
+		return &Circle{}
+	}
+	list := make([]Vector2, len(points))
+	copy(list, points)
+	var support [3]Vector2
+	c := minimalCircle(list, len(list), support[:0])
+	return &c
+}
+
+// Returns the smallest circle containing the first n points with the support points on its boundary.
+// Points outside of the circle are moved to the front of the list, so that they are checked first later on.
+func minimalCircle(points []Vector2, n int, support []Vector2) Circle {
+	c := circleFromSupport(support)
+	if len(support) == 3 {
+		return c
+	}
+	for i := 0; i < n; i++ {
+		p := points[i]
+		if c.Radius >= 0 && Vec2(c.X, c.Y).Distance(p) <= c.Radius*(1+1e-5) {
+			continue
+		}
+		c = minimalCircle(points, i, append(support, p))
+		copy(points[1:i+1], points[:i])
+		points[0] = p
+	}
+	return c
+}
+
+// Returns the smallest circle with all points on its boundary, the empty circle has a negative radius.
+// Three points on a line result in the circle around the two outer ones.
+func circleFromSupport(points []Vector2) Circle {
+	switch len(points) {
+	case 0:
+		return Circle{0, 0, -1}
+	case 1:
+		return Circle{points[0].X, points[0].Y, 0}
+	case 2:
+		center := points[0].Lerp(points[1], 0.5)
+		return Circle{center.X, center.Y, points[0].Distance(points[1]) / 2}
+	}
+	a, b := points[0].Sub(points[2]), points[1].Sub(points[2])
+	denom := 2 * a.Cross(b)
+	if Abs(denom) <= 1e-6*a.Len()*b.Len() {
+		widest := circleFromSupport(points[:2])
+		for _, pair := range [2][2]int{{0, 2}, {1, 2}} {
+			if c := circleFromSupport([]Vector2{points[pair[0]], points[pair[1]]}); c.Radius > widest.Radius {
+				widest = c
+			}
+		}
+		return widest
+	}
+	center := points[2].Add(Vec2(b.Y*a.Len2()-a.Y*b.Len2(), a.X*b.Len2()-b.X*a.Len2()).Scale(1 / denom))
+	var radius float32
+	for _, p := range points {
+		radius = Max(radius, center.Distance(p))
+	}
+	return Circle{center.X, center.Y, radius}
+}
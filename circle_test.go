@@ -1,6 +1,8 @@
 package math
 
 import (
+	"math/rand"
+
 	. "launchpad.net/gocheck"
 )
 
@@ -34,3 +36,41 @@ func (s *CircleTestSuite) TestCircle(c *C) {
 	// Set
 	c.Assert(s.circle.Set(1, 1.1, 0.0), Equals, Circ(1, 1.1, 0.0))
 }
+
+func (s *CircleTestSuite) TestMinimalCircle(c *C) {
+	c.Check(*NewMinimalCircle(nil), Equals, Circle{})
+	c.Check(*NewMinimalCircle([]Vector2{Vec2(1, 2)}), Equals, Circ(1, 2, 0))
+	c.Check(*NewMinimalCircle([]Vector2{Vec2(0, 0), Vec2(3, 0), Vec2(1, 0), Vec2(-1, 0)}), Equals, Circ(1, 0, 2))
+
+	circle := NewMinimalCircle([]Vector2{Vec2(0, 0), Vec2(4, 0), Vec2(0, 4), Vec2(4, 4), Vec2(1, 3)})
+	c.Check(circle.X, EqualsFloat32, float32(2))
+	c.Check(circle.Y, EqualsFloat32, float32(2))
+	c.Check(circle.Radius, EqualsFloat32, Sqrt(8))
+
+	// An obtuse triangle is enclosed by the circle around its longest side.
+	c.Check(*NewMinimalCircle([]Vector2{Vec2(0, 0), Vec2(4, 0), Vec2(2, 0.5)}), Equals, Circ(2, 0, 2))
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		points := make([]Vector2, 2+rnd.Intn(200))
+		for j := range points {
+			points[j] = Vec2(rnd.Float32()*10-3, rnd.Float32()*4+1)
+		}
+		circle := NewMinimalCircle(points)
+		center := Vec2(circle.X, circle.Y)
+		touching := 0
+		for _, p := range points {
+			c.Check(center.Distance(p) <= circle.Radius*1.0001, Equals, true)
+			if Abs(center.Distance(p)-circle.Radius) <= circle.Radius*1e-4 {
+				touching++
+			}
+		}
+		c.Check(touching >= 2, Equals, true)
+		// The minimal sphere of points in a plane has the radius of their minimal circle.
+		points3 := make([]Vector3, len(points))
+		for j, p := range points {
+			points3[j] = p.Vec3()
+		}
+		c.Check(circle.Radius, EqualsFloat32, NewMinimalSphere(points3).Radius)
+	}
+}
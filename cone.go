@@ -0,0 +1,105 @@
+package math
+
+// A cone with the tip at Apex and a circular base with the Radius around Base.
+type Cone struct {
+	Apex, Base Vector3
+	Radius     float32
+}
+
+func NewCone(apex, base Vector3, radius float32) *Cone {
+	return &Cone{apex, base, radius}
+}
+
+func (c *Cone) Volume() float32 {
+	return Pi * c.Radius * c.Radius * c.Apex.Distance(c.Base) / 3
+}
+
+// Returns the smallest axis aligned box around the cone.
+func (c *Cone) Bounds() BoundingBox {
+	extent := diskExtent(c.Apex.Sub(c.Base), c.Radius)
+	return BoundingBox{Min: c.Base.Sub(extent).Min(c.Apex), Max: c.Base.Add(extent).Max(c.Apex)}
+}
+
+// Returns whether the point is inside of the cone or on its surface.
+func (c *Cone) ContainsVec(point Vector3) bool {
+	axial, radial, height := axialCoordinates(point, c.Base, c.Apex)
+	if axial < 0 || axial > height {
+		return false
+	}
+	r := c.Radius * (1 - axial/height)
+	return radial.Len2() <= r*r
+}
+
+// Returns the point of the cone nearest to the point, the point itself if it is inside.
+func (c *Cone) ClosestPoint(point Vector3) Vector3 {
+	if c.ContainsVec(point) {
+		return point
+	}
+	// The closest point lies in the half plane through the axis and the point, where the cone is a triangle.
+	axial, radial, height := axialCoordinates(point, c.Base, c.Apex)
+	section := Triangle{Vec3(0, 0, 0), Vec3(c.Radius, 0, 0), Vec3(0, height, 0)}
+	closest := section.ClosestPoint(Vec3(radial.Len(), axial, 0))
+	axis := c.Apex.Sub(c.Base).Scale(1 / height)
+	return c.Base.Add(axis.Scale(closest.Y)).Add(radial.Nor().Scale(closest.X))
+}
+
+// Returns the point of the cone farthest in the direction.
+func (c *Cone) Support(direction Vector3) Vector3 {
+	axis := c.Apex.Sub(c.Base).Nor()
+	radial := direction.Sub(axis.Scale(axis.Dot(direction)))
+	rim := c.Base.Add(radial.Nor().Scale(c.Radius))
+	if c.Apex.Dot(direction) > rim.Dot(direction) {
+		return c.Apex
+	}
+	return rim
+}
+
+// Returns whether the ray hits the cone and the distance of the hit in multiples of the ray direction.
+// The distance is 0 if the ray starts inside of the cone.
+func (c *Cone) IntersectRay(ray *Ray) (float32, bool) {
+	if c.ContainsVec(ray.Origin) {
+		return 0, true
+	}
+	distance, ok := intersectRayDisk(ray, c.Base, c.Apex.Sub(c.Base), c.Radius)
+
+	// Intersect the infinite double cone around the axis with the squared cosine of the half angle,
+	// the hits have to be between the apex and the base.
+	axis := c.Base.Sub(c.Apex)
+	height := axis.Len()
+	axis = axis.Scale(1 / height)
+	cos2 := height * height / (height*height + c.Radius*c.Radius)
+	w, n := ray.Origin.Sub(c.Apex), ray.Direction
+	dv, wv := n.Dot(axis), w.Dot(axis)
+	qa := dv*dv - cos2*n.Dot(n)
+	qb := 2 * (dv*wv - cos2*n.Dot(w))
+	qc := wv*wv - cos2*w.Dot(w)
+	// Rays through the apex touch the cone, where rounding may push the discriminant slightly below 0.
+	const epsilon = 1e-6
+	discriminant := qb*qb - 4*qa*qc
+	if discriminant < 0 && discriminant > -epsilon*qb*qb {
+		discriminant = 0
+	}
+	var roots []float32
+	if qa == 0 {
+		if qb != 0 {
+			roots = append(roots, -qc/qb)
+		}
+	} else if discriminant >= 0 {
+		s := Sqrt(discriminant)
+		t1, t2 := (-qb-s)/(2*qa), (-qb+s)/(2*qa)
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		roots = append(roots, t1, t2)
+	}
+	for _, t := range roots {
+		if t < 0 || (ok && t >= distance) {
+			continue
+		}
+		if along := ray.GetEndPoint(t).Sub(c.Apex).Dot(axis); along >= -epsilon*height && along <= height {
+			distance, ok = t, true
+			break
+		}
+	}
+	return distance, ok
+}
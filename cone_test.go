@@ -0,0 +1,36 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type ConeTestSuite struct{}
+
+var _ = Suite(&ConeTestSuite{})
+
+func (s *ConeTestSuite) TestCone(c *C) {
+	cone := NewCone(Vec3(0, 2, 0), Vec3(0, 0, 0), 1)
+	c.Check(cone.Volume(), EqualsFloat32, 2*Pi/3)
+	c.Check(cone.Bounds(), Equals, BoundingBox{Min: Vec3(-1, 0, -1), Max: Vec3(1, 2, 1)})
+	c.Check(cone.ContainsVec(Vec3(0.4, 1, 0)), Equals, true)
+	c.Check(cone.ContainsVec(Vec3(0.6, 1, 0)), Equals, false)
+	c.Check(cone.ClosestPoint(Vec3(0, 3, 0)), Equals, Vec3(0, 2, 0))
+	c.Check(cone.ClosestPoint(Vec3(0.5, -1, 0)), Equals, Vec3(0.5, 0, 0))
+	c.Check(cone.ClosestPoint(Vec3(1, 1.5, 0)), Vector3Check, Vec3(0.4, 1.2, 0))
+	c.Check(cone.Support(Vec3(0, 1, 0)), Equals, Vec3(0, 2, 0))
+	c.Check(cone.Support(Vec3(1, 0.1, 0)), Equals, Vec3(1, 0, 0))
+	distance, ok := cone.IntersectRay(NewRay(Vec3(0, 5, 0), Vec3(0, -1, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(3))
+	distance, ok = cone.IntersectRay(NewRay(Vec3(-5, 1, 0), Vec3(1, 0, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(4.5))
+	distance, ok = cone.IntersectRay(NewRay(Vec3(0.5, -1, 0), Vec3(0, 1, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(1))
+	// The lower half of the double cone doesn't count.
+	_, ok = cone.IntersectRay(NewRay(Vec3(-5, 5, 0), Vec3(1, 0, 0)))
+	c.Check(ok, Equals, false)
+
+	checkSolid(c, NewCone(Vec3(1, -2, 0.5), Vec3(-1, 3, 2), 1.5), 3)
+}
@@ -0,0 +1,96 @@
+package math
+
+// A cylinder between the centers of its caps Segment.A and Segment.B.
+type Cylinder struct {
+	Segment Segment
+	Radius  float32
+}
+
+func NewCylinder(a, b Vector3, radius float32) *Cylinder {
+	return &Cylinder{Segment{a, b}, radius}
+}
+
+func (c *Cylinder) Volume() float32 {
+	return Pi * c.Radius * c.Radius * c.Segment.B.Distance(c.Segment.A)
+}
+
+// Returns the smallest axis aligned box around the cylinder.
+func (c *Cylinder) Bounds() BoundingBox {
+	extent := diskExtent(c.Segment.B.Sub(c.Segment.A), c.Radius)
+	return BoundingBox{Min: c.Segment.A.Min(c.Segment.B).Sub(extent), Max: c.Segment.A.Max(c.Segment.B).Add(extent)}
+}
+
+// Returns the extent of a disk with the normal along each axis.
+func diskExtent(normal Vector3, radius float32) Vector3 {
+	n := normal.Nor()
+	return Vec3(Sqrt(Max(0, 1-n.X*n.X)), Sqrt(Max(0, 1-n.Y*n.Y)), Sqrt(Max(0, 1-n.Z*n.Z))).Scale(radius)
+}
+
+// Returns the distance of the point along the axis from a to b, its offset from the axis and the length of the axis.
+func axialCoordinates(point, a, b Vector3) (axial float32, radial Vector3, height float32) {
+	axis := b.Sub(a)
+	height = axis.Len()
+	d := point.Sub(a)
+	axial = d.Dot(axis) / height
+	radial = d.Sub(axis.Scale(axial / height))
+	return axial, radial, height
+}
+
+// Returns whether the point is inside of the cylinder or on its surface.
+func (c *Cylinder) ContainsVec(point Vector3) bool {
+	axial, radial, height := axialCoordinates(point, c.Segment.A, c.Segment.B)
+	return axial >= 0 && axial <= height && radial.Len2() <= c.Radius*c.Radius
+}
+
+// Returns the point of the cylinder nearest to the point, the point itself if it is inside.
+func (c *Cylinder) ClosestPoint(point Vector3) Vector3 {
+	if c.ContainsVec(point) {
+		return point
+	}
+	axial, radial, height := axialCoordinates(point, c.Segment.A, c.Segment.B)
+	if radial.Len2() > c.Radius*c.Radius {
+		radial = radial.Nor().Scale(c.Radius)
+	}
+	axis := c.Segment.B.Sub(c.Segment.A).Scale(1 / height)
+	return c.Segment.A.Add(axis.Scale(Clampf(axial, 0, height))).Add(radial)
+}
+
+// Returns the point of the cylinder farthest in the direction.
+func (c *Cylinder) Support(direction Vector3) Vector3 {
+	support := c.Segment.A
+	if c.Segment.B.Dot(direction) > support.Dot(direction) {
+		support = c.Segment.B
+	}
+	axis := c.Segment.B.Sub(c.Segment.A).Nor()
+	radial := direction.Sub(axis.Scale(axis.Dot(direction)))
+	return support.Add(radial.Nor().Scale(c.Radius))
+}
+
+// Returns whether the ray hits the cylinder and the distance of the hit in multiples of the ray direction.
+// The distance is 0 if the ray starts inside of the cylinder.
+func (c *Cylinder) IntersectRay(ray *Ray) (float32, bool) {
+	if c.ContainsVec(ray.Origin) {
+		return 0, true
+	}
+	distance, ok := intersectRayCylinderSide(ray, c.Segment.A, c.Segment.B, c.Radius)
+	axis := c.Segment.B.Sub(c.Segment.A)
+	for _, center := range [2]Vector3{c.Segment.A, c.Segment.B} {
+		if d, hit := intersectRayDisk(ray, center, axis, c.Radius); hit && (!ok || d < distance) {
+			distance, ok = d, true
+		}
+	}
+	return distance, ok
+}
+
+// Returns where the ray hits the disk with the center and normal.
+func intersectRayDisk(ray *Ray, center, normal Vector3, radius float32) (float32, bool) {
+	denom := ray.Direction.Dot(normal)
+	if denom == 0 {
+		return 0, false
+	}
+	t := center.Sub(ray.Origin).Dot(normal) / denom
+	if t < 0 {
+		return 0, false
+	}
+	return t, ray.GetEndPoint(t).Distance2(center) <= radius*radius
+}
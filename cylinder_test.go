@@ -0,0 +1,30 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type CylinderTestSuite struct{}
+
+var _ = Suite(&CylinderTestSuite{})
+
+func (s *CylinderTestSuite) TestCylinder(c *C) {
+	cylinder := NewCylinder(Vec3(0, 0, 0), Vec3(0, 2, 0), 1)
+	c.Check(cylinder.Volume(), EqualsFloat32, 2*Pi)
+	c.Check(cylinder.Bounds(), Equals, BoundingBox{Min: Vec3(-1, 0, -1), Max: Vec3(1, 2, 1)})
+	c.Check(cylinder.ContainsVec(Vec3(0.9, 1.9, 0)), Equals, true)
+	c.Check(cylinder.ContainsVec(Vec3(0, 2.1, 0)), Equals, false)
+	c.Check(cylinder.ClosestPoint(Vec3(3, 3, 0)), Equals, Vec3(1, 2, 0))
+	c.Check(cylinder.ClosestPoint(Vec3(0.5, -3, 0)), Equals, Vec3(0.5, 0, 0))
+	c.Check(cylinder.Support(Vec3(1, -1, 0)), Equals, Vec3(1, 0, 0))
+	distance, ok := cylinder.IntersectRay(NewRay(Vec3(0.5, 5, 0), Vec3(0, -1, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(3))
+	distance, ok = cylinder.IntersectRay(NewRay(Vec3(-5, 1, 0), Vec3(2, 0, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(2))
+	_, ok = cylinder.IntersectRay(NewRay(Vec3(-5, 2.5, 0), Vec3(1, 0, 0)))
+	c.Check(ok, Equals, false)
+
+	checkSolid(c, NewCylinder(Vec3(1, -2, 0.5), Vec3(-1, 3, 2), 0.8), 2)
+}
@@ -0,0 +1,174 @@
+package math
+
+// A dual quaternion Real + ε*Dual which represents a rigid transformation (rotation followed by translation).
+// Unlike matrices, dual quaternions can be blended without shrinking the geometry which makes them
+// suitable for skinning.
+type DualQuaternion struct {
+	Real Quaternion
+	Dual Quaternion
+}
+
+// Returns the dual quaternion which first rotates by rotation and then translates by translation.
+func NewDualQuaternion(rotation *Quaternion, translation Vector3) *DualQuaternion {
+	return (&DualQuaternion{}).Set(rotation, translation)
+}
+
+func NewIdentityDualQuaternion() *DualQuaternion {
+	return (&DualQuaternion{}).Idt()
+}
+
+// Sets the dual quaternion to the rotation followed by the translation.
+func (dq *DualQuaternion) Set(rotation *Quaternion, translation Vector3) *DualQuaternion {
+	dq.Real = *rotation
+	dq.Real.Nor()
+	dq.Dual = *NewQuaternion(translation.X, translation.Y, translation.Z, 0).Mul(&dq.Real).Scale(0.5)
+	return dq
+}
+
+func (dq *DualQuaternion) Cpy() *DualQuaternion {
+	return &DualQuaternion{dq.Real, dq.Dual}
+}
+
+func (dq *DualQuaternion) Idt() *DualQuaternion {
+	dq.Real.Idt()
+	dq.Dual.Set(0, 0, 0, 0)
+	return dq
+}
+
+// Returns the rotation part.
+func (dq *DualQuaternion) Rotation() *Quaternion {
+	return dq.Real.Cpy()
+}
+
+// Returns the translation part.
+func (dq *DualQuaternion) Translation() Vector3 {
+	t := dq.Dual.Cpy().Mul(dq.Real.Cpy().Conjugate()).Scale(2)
+	return Vec3(t.X, t.Y, t.Z)
+}
+
+// Multiplies this dual quaternion with another one.
+// The result applies the other transformation first and then this one.
+func (dq *DualQuaternion) Mul(other *DualQuaternion) *DualQuaternion {
+	d1 := dq.Real.Cpy().Mul(&other.Dual)
+	d2 := dq.Dual.Cpy().Mul(&other.Real)
+	dq.Real.Mul(&other.Real)
+	dq.Dual.Set(d1.X+d2.X, d1.Y+d2.Y, d1.Z+d2.Z, d1.W+d2.W)
+	return dq
+}
+
+// Conjugates both quaternion parts. For a unit dual quaternion this is the inverse transformation.
+func (dq *DualQuaternion) Conjugate() *DualQuaternion {
+	dq.Real.Conjugate()
+	dq.Dual.Conjugate()
+	return dq
+}
+
+// Negates the dual part.
+func (dq *DualQuaternion) DualConjugate() *DualQuaternion {
+	dq.Dual.Scale(-1)
+	return dq
+}
+
+// Conjugates both quaternion parts and negates the dual part.
+// Points are transformed by dq * (1 + ε*point) * dq.CombinedConjugate().
+func (dq *DualQuaternion) CombinedConjugate() *DualQuaternion {
+	return dq.Conjugate().DualConjugate()
+}
+
+// Normalizes this dual quaternion so that it represents a rigid transformation again.
+// The real part gets unit length and the dual part is made orthogonal to it.
+func (dq *DualQuaternion) Nor() *DualQuaternion {
+	l := dq.Real.Len()
+	if l == 0 {
+		return dq
+	}
+	dq.Real.Scale(1 / l)
+	dq.Dual.Scale(1 / l)
+	d := dq.Real.Dot(&dq.Dual)
+	dq.Dual.Set(dq.Dual.X-d*dq.Real.X, dq.Dual.Y-d*dq.Real.Y, dq.Dual.Z-d*dq.Real.Z, dq.Dual.W-d*dq.Real.W)
+	return dq
+}
+
+// Returns the point transformed by this unit dual quaternion.
+func (dq *DualQuaternion) Transform(point Vector3) Vector3 {
+	return dq.Real.Transform(point).Add(dq.Translation())
+}
+
+// Returns the transformation matrix of this unit dual quaternion.
+func (dq *DualQuaternion) Matrix() *Matrix4 {
+	m := dq.Real.Matrix()
+	t := dq.Translation()
+	m.M41 = t.X
+	m.M42 = t.Y
+	m.M43 = t.Z
+	return m
+}
+
+// Sets this dual quaternion from a matrix which only consists of a rotation and a translation.
+func (dq *DualQuaternion) SetFromMatrix(m *Matrix4) *DualQuaternion {
+	return dq.Set(NewQuaternion(0, 0, 0, 1).SetFromMatrix(m), Vec3(m.M41, m.M42, m.M43))
+}
+
+// Screw linear interpolation between this and the end dual quaternion, based on the alpha value in the range [0,1].
+// The rotation and the translation are interpolated along the screw motion between both transformations
+// with constant speed, taking the shortest path.
+func (dq *DualQuaternion) ScLerp(end *DualQuaternion, alpha float32) *DualQuaternion {
+	diff := dq.Cpy().Conjugate().Mul(end)
+	if diff.Real.W < 0 {
+		diff.Real.Scale(-1)
+		diff.Dual.Scale(-1)
+	}
+	return dq.Mul(diff.Pow(alpha)).Nor()
+}
+
+// Raises this unit dual quaternion to the given power, which scales the angle and the distance of its screw motion.
+func (dq *DualQuaternion) Pow(exponent float32) *DualQuaternion {
+	vLen := Sqrt(dq.Real.X*dq.Real.X + dq.Real.Y*dq.Real.Y + dq.Real.Z*dq.Real.Z)
+	if vLen < 0.000001 {
+		// Pure translation
+		t := dq.Translation().Scale(exponent)
+		return dq.Set(NewQuaternion(0, 0, 0, 1), t)
+	}
+
+	// Screw parameters: angle, pitch, direction and moment of the screw axis.
+	angle := 2 * Atan2(vLen, dq.Real.W)
+	invLen := 1 / vLen
+	direction := Vec3(dq.Real.X, dq.Real.Y, dq.Real.Z).Scale(invLen)
+	pitch := -2 * dq.Dual.W * invLen
+	moment := Vec3(dq.Dual.X, dq.Dual.Y, dq.Dual.Z).Sub(direction.Scale(pitch * 0.5 * dq.Real.W)).Scale(invLen)
+
+	angle *= exponent
+	pitch *= exponent
+	sin, cos := Sincos(angle * 0.5)
+
+	realVec := direction.Scale(sin)
+	dualVec := moment.Scale(sin).Add(direction.Scale(pitch * 0.5 * cos))
+	dq.Real.Set(realVec.X, realVec.Y, realVec.Z, cos)
+	dq.Dual.Set(dualVec.X, dualVec.Y, dualVec.Z, -pitch*0.5*sin)
+	return dq
+}
+
+// Dual quaternion linear blending of the transformations with the given weights, used for skinning.
+// All transformations are flipped into the hemisphere of the first one before they are blended.
+// There has to be one weight per transformation, otherwise ErrDimensionMismatch is returned.
+func BlendDualQuaternions(transforms []DualQuaternion, weights []float32) (*DualQuaternion, error) {
+	if len(weights) != len(transforms) {
+		return nil, ErrDimensionMismatch
+	}
+	result := &DualQuaternion{}
+	if len(transforms) == 0 {
+		return result.Idt(), nil
+	}
+	pivot := &transforms[0].Real
+	for i := range transforms {
+		w := weights[i]
+		if transforms[i].Real.Dot(pivot) < 0 {
+			w = -w
+		}
+		r := &transforms[i].Real
+		d := &transforms[i].Dual
+		result.Real.Set(result.Real.X+r.X*w, result.Real.Y+r.Y*w, result.Real.Z+r.Z*w, result.Real.W+r.W*w)
+		result.Dual.Set(result.Dual.X+d.X*w, result.Dual.Y+d.Y*w, result.Dual.Z+d.Z*w, result.Dual.W+d.W*w)
+	}
+	return result.Nor(), nil
+}
@@ -0,0 +1,121 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type DualQuaternionTestSuite struct{}
+
+var _ = Suite(&DualQuaternionTestSuite{})
+
+// Returns the screw motion which rotates by angle around the axis through pivot and moves by distance along it.
+func newScrew(pivot, axis Vector3, angle, distance float32) *DualQuaternion {
+	toPivot := NewDualQuaternion(NewQuaternion(0, 0, 0, 1), pivot)
+	fromPivot := NewDualQuaternion(NewQuaternion(0, 0, 0, 1), pivot.Scale(-1))
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(axis.X, axis.Y, axis.Z, angle)
+	screw := NewDualQuaternion(rotation, axis.Nor().Scale(distance))
+	return toPivot.Mul(screw).Mul(fromPivot)
+}
+
+func (s *DualQuaternionTestSuite) TestTransform(c *C) {
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2)
+	dq := NewDualQuaternion(rotation, Vec3(1, 2, 3))
+	c.Check(dq.Transform(Vec3(1, 1, 1)), Vector3Check, Vec3(0, 3, 4))
+	c.Check(dq.Translation(), Vector3Check, Vec3(1, 2, 3))
+	c.Check(sameRotation(dq.Rotation(), rotation), Equals, true)
+}
+
+func (s *DualQuaternionTestSuite) TestMul(c *C) {
+	a := NewDualQuaternion(NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7), Vec3(1, -2, 5))
+	b := NewDualQuaternion(NewQuaternion(0, 0, 0, 1).SetFromAxis(-2, 0.5, 1, 2.5), Vec3(-3, 4, 2))
+	point := Vec3(2, 3, 4)
+	c.Check(a.Cpy().Mul(b).Transform(point), Vector3Check, a.Transform(b.Transform(point)))
+	c.Check(a.Cpy().Mul(b).Matrix(), Matrix4Check, a.Matrix().Mul(b.Matrix()))
+}
+
+func (s *DualQuaternionTestSuite) TestConjugate(c *C) {
+	dq := NewDualQuaternion(NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7), Vec3(1, -2, 5))
+	point := Vec3(2, 3, 4)
+	c.Check(dq.Cpy().Conjugate().Transform(dq.Transform(point)), Vector3Check, point)
+
+	// Transforming a point with the sandwich product.
+	p := &DualQuaternion{Real: *NewQuaternion(0, 0, 0, 1), Dual: *NewQuaternion(point.X, point.Y, point.Z, 0)}
+	result := dq.Cpy().Mul(p).Mul(dq.Cpy().CombinedConjugate())
+	c.Check(Vec3(result.Dual.X, result.Dual.Y, result.Dual.Z), Vector3Check, dq.Transform(point))
+}
+
+func (s *DualQuaternionTestSuite) TestNor(c *C) {
+	dq := NewDualQuaternion(NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7), Vec3(1, -2, 5))
+	scaled := &DualQuaternion{*dq.Real.Cpy().Scale(3), *dq.Dual.Cpy().Scale(3)}
+	scaled.Nor()
+	c.Check(scaled.Real.Len(), EqualsFloat32, float32(1))
+	c.Check(scaled.Transform(Vec3(2, 3, 4)), Vector3Check, dq.Transform(Vec3(2, 3, 4)))
+
+	// Drift in the dual part is removed.
+	scaled.Dual.X += 0.01
+	scaled.Nor()
+	c.Check(Abs(scaled.Real.Dot(&scaled.Dual)) < 0.00001, Equals, true)
+}
+
+func (s *DualQuaternionTestSuite) TestMatrix(c *C) {
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(-2, 0.5, 1, 2.5)
+	dq := NewDualQuaternion(rotation, Vec3(-3, 4, 2))
+	m := dq.Matrix()
+	c.Check(m, Matrix4Check, NewTranslationMatrix4(-3, 4, 2).Mul(rotation.Matrix()))
+	c.Check(m.MulVec3(Vec3(2, 3, 4)), Vector3Check, dq.Transform(Vec3(2, 3, 4)))
+
+	fromMatrix := (&DualQuaternion{}).SetFromMatrix(m)
+	c.Check(fromMatrix.Transform(Vec3(2, 3, 4)), Vector3Check, dq.Transform(Vec3(2, 3, 4)))
+}
+
+func (s *DualQuaternionTestSuite) TestScLerp(c *C) {
+	start := NewIdentityDualQuaternion()
+	end := newScrew(Vec3(1, 0, 0), Vec3(0, 0, 1), Pi/2, 2)
+	point := Vec3(3, 2, 1)
+
+	c.Check(start.Cpy().ScLerp(end, 0).Transform(point), Vector3Check, point)
+	c.Check(start.Cpy().ScLerp(end, 1).Transform(point), Vector3Check, end.Transform(point))
+
+	// Halfway along the screw the rotation and translation are halved.
+	half := newScrew(Vec3(1, 0, 0), Vec3(0, 0, 1), Pi/4, 1)
+	c.Check(start.Cpy().ScLerp(end, 0.5).Transform(point), Vector3Check, half.Transform(point))
+
+	// Interpolating between two arbitrary transformations.
+	a := NewDualQuaternion(NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7), Vec3(1, -2, 5))
+	b := a.Cpy().Mul(end)
+	c.Check(a.Cpy().ScLerp(b, 0.5).Transform(point), Vector3Check, a.Cpy().Mul(half).Transform(point))
+}
+
+func (s *DualQuaternionTestSuite) TestScLerpTranslation(c *C) {
+	start := NewDualQuaternion(NewQuaternion(0, 0, 0, 1), Vec3(1, 2, 3))
+	end := NewDualQuaternion(NewQuaternion(0, 0, 0, 1), Vec3(5, 2, -1))
+	c.Check(start.ScLerp(end, 0.25).Translation(), Vector3Check, Vec3(2, 2, 2))
+}
+
+func (s *DualQuaternionTestSuite) TestBlend(c *C) {
+	a := NewDualQuaternion(NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2), Vec3(1, 2, 3))
+	b := a.Cpy()
+	// The same transformation in the other hemisphere.
+	b.Real.Scale(-1)
+	b.Dual.Scale(-1)
+	blended, err := BlendDualQuaternions([]DualQuaternion{*a, *b}, []float32{0.3, 0.7})
+	c.Check(err, IsNil)
+	c.Check(blended.Transform(Vec3(1, 1, 1)), Vector3Check, a.Transform(Vec3(1, 1, 1)))
+
+	// Blending rotations around the same pivot keeps the distance to the pivot.
+	pivot := Vec3(1, 1, 0)
+	r1 := newScrew(pivot, Vec3(0, 0, 1), 0, 0)
+	r2 := newScrew(pivot, Vec3(0, 0, 1), Pi/2, 0)
+	blended, err = BlendDualQuaternions([]DualQuaternion{*r1, *r2}, []float32{0.5, 0.5})
+	c.Check(err, IsNil)
+	c.Check(blended.Transform(Vec3(3, 1, 0)).Sub(pivot).Len(), EqualsFloat32, float32(2))
+
+	// Every transformation needs a weight.
+	_, err = BlendDualQuaternions([]DualQuaternion{*r1, *r2}, []float32{1})
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = BlendDualQuaternions(nil, []float32{1})
+	c.Check(err, Equals, ErrDimensionMismatch)
+	blended, err = BlendDualQuaternions(nil, nil)
+	c.Check(err, IsNil)
+	c.Check(*blended, Equals, *new(DualQuaternion).Idt())
+}
@@ -0,0 +1,522 @@
+package math
+
+import (
+	"sort"
+)
+
+// The default margin by which the bounds of the proxies in a dynamic tree are enlarged.
+const DefaultDynamicTreeMargin = 0.1
+
+const (
+	// The bounds of a moving proxy are extended by this multiple of its displacement.
+	dynamicTreeDisplacementMultiplier = 4
+	nullNode                          = -1
+)
+
+type dynamicTreeNode struct {
+	// The fat bounds of a leaf or the union of the children of an inner node.
+	bounds BoundingBox
+	// The parent of a node in the tree, the next free node of a freed node.
+	parent         int
+	child1, child2 int
+	// 0 for leaves, -1 for freed nodes.
+	height int
+	// The proxy of a leaf.
+	proxy int
+}
+
+func (n *dynamicTreeNode) isLeaf() bool {
+	return n.height == 0
+}
+
+// A bounding volume hierarchy for moving objects, e.g. for the broadphase of a physics engine.
+// Each object is represented by a proxy whose bounds are enlarged by a margin,
+// so that small movements don't require an update of the tree.
+// The tree is kept balanced with rotations while proxies are inserted, removed and moved.
+// All queries test against the enlarged bounds.
+// Proxies are identified by the id returned from Insert, see itemPool.
+type DynamicTree struct {
+	nodes   []dynamicTreeNode
+	root    int
+	free    int
+	proxies itemPool
+	// The leaf node of each proxy.
+	leaves []int
+	margin float32
+	cost   func(box *BoundingBox) float32
+}
+
+// Returns an empty tree which enlarges the bounds of the proxies by margin.
+func NewDynamicTree(margin float32) *DynamicTree {
+	return newDynamicTree(margin, (*BoundingBox).SurfaceArea)
+}
+
+func newDynamicTree(margin float32, cost func(box *BoundingBox) float32) *DynamicTree {
+	return &DynamicTree{root: nullNode, free: nullNode, margin: margin, cost: cost}
+}
+
+// The number of proxies
+func (t *DynamicTree) Len() int {
+	return t.proxies.count
+}
+
+// The height of the tree, 0 if it contains a single proxy and -1 if it is empty.
+func (t *DynamicTree) Height() int {
+	if t.root == nullNode {
+		return -1
+	}
+	return t.nodes[t.root].height
+}
+
+// Inserts a proxy with the bounds and returns its id. data can be used to associate an object with the proxy.
+func (t *DynamicTree) Insert(bounds BoundingBox, data interface{}) int {
+	proxy := t.proxies.insert(data)
+	if proxy == len(t.leaves) {
+		t.leaves = append(t.leaves, nullNode)
+	}
+	leaf := t.allocate()
+	node := &t.nodes[leaf]
+	node.bounds = t.fatten(bounds, Vector3{})
+	node.height = 0
+	node.proxy = proxy
+	t.leaves[proxy] = leaf
+	t.insertLeaf(leaf)
+	return proxy
+}
+
+// Removes the proxy, see itemPool.
+func (t *DynamicTree) Remove(proxy int) {
+	t.proxies.remove(proxy)
+	leaf := t.leaves[proxy]
+	t.removeLeaf(leaf)
+	t.release(leaf)
+	t.leaves[proxy] = nullNode
+}
+
+// Updates the bounds of the proxy which moved by displacement since the last update.
+// The proxy is only reinserted if the bounds left its enlarged bounds or
+// if they are much smaller, in that case Move returns true.
+// The enlarged bounds are extended in the direction of the displacement.
+func (t *DynamicTree) Move(proxy int, bounds BoundingBox, displacement Vector3) bool {
+	t.proxies.check(proxy)
+	leaf := t.leaves[proxy]
+	node := &t.nodes[leaf]
+	if node.bounds.Contains(&bounds) {
+		margin := Vec3(4*t.margin, 4*t.margin, 4*t.margin)
+		fat := t.fatten(bounds, displacement)
+		huge := BoundingBox{Min: fat.Min.Sub(margin), Max: fat.Max.Add(margin)}
+		if huge.Contains(&node.bounds) {
+			return false
+		}
+	}
+	t.removeLeaf(leaf)
+	t.nodes[leaf].bounds = t.fatten(bounds, displacement)
+	t.insertLeaf(leaf)
+	return true
+}
+
+// The enlarged bounds of the proxy.
+func (t *DynamicTree) FatBounds(proxy int) BoundingBox {
+	t.proxies.check(proxy)
+	return t.nodes[t.leaves[proxy]].bounds
+}
+
+// The data given when the proxy was inserted.
+func (t *DynamicTree) Data(proxy int) interface{} {
+	return t.proxies.get(proxy)
+}
+
+// Appends the proxies whose enlarged bounds overlap the box to result.
+func (t *DynamicTree) Query(box *BoundingBox, result []int) []int {
+	if t.root == nullNode {
+		return result
+	}
+	stack := make([]int, 1, 64)
+	stack[0] = t.root
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := &t.nodes[index]
+		if !node.bounds.Overlaps(box) {
+			continue
+		}
+		if node.isLeaf() {
+			result = append(result, node.proxy)
+			continue
+		}
+		stack = append(stack, node.child2, node.child1)
+	}
+	return result
+}
+
+// Calls pair for all pairs of proxies whose enlarged bounds overlap, with a < b.
+func (t *DynamicTree) Pairs(pair func(a, b int)) {
+	var overlapping []int
+	for i := range t.nodes {
+		node := &t.nodes[i]
+		if !node.isLeaf() {
+			continue
+		}
+		overlapping = t.Query(&node.bounds, overlapping[:0])
+		for _, other := range overlapping {
+			if other > node.proxy {
+				pair(node.proxy, other)
+			}
+		}
+	}
+}
+
+// Returns the nearest proxy hit by the ray within maxDistance and the distance in multiples of the ray direction.
+// hit calculates the exact intersection of the ray with the object of a proxy,
+// if it is nil the enlarged bounds of the proxies are used.
+func (t *DynamicTree) RayCast(ray *Ray, maxDistance float32, hit func(proxy int, ray *Ray) (float32, bool)) (proxy int, distance float32, ok bool) {
+	if t.root == nullNode {
+		return -1, 0, false
+	}
+	invDir := Vec3(1/ray.Direction.X, 1/ray.Direction.Y, 1/ray.Direction.Z)
+	proxy = -1
+	distance = maxDistance
+	stack := make([]int, 1, 64)
+	stack[0] = t.root
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := &t.nodes[index]
+		d, ok := intersectRayBounds(ray.Origin, invDir, &node.bounds, distance)
+		if !ok {
+			continue
+		}
+		if node.isLeaf() {
+			if hit != nil {
+				d, ok = hit(node.proxy, ray)
+			}
+			if ok && d <= distance {
+				proxy, distance = node.proxy, d
+			}
+			continue
+		}
+		// Visit the nearer child first so that the farther one can be culled.
+		near, far := node.child1, node.child2
+		dNear, okNear := intersectRayBounds(ray.Origin, invDir, &t.nodes[near].bounds, distance)
+		dFar, okFar := intersectRayBounds(ray.Origin, invDir, &t.nodes[far].bounds, distance)
+		if okNear && okFar && dFar < dNear {
+			near, far = far, near
+		}
+		if okFar {
+			stack = append(stack, far)
+		}
+		if okNear {
+			stack = append(stack, near)
+		}
+	}
+	return proxy, distance, proxy >= 0
+}
+
+// Rebuilds the inner nodes of the tree from scratch. This gives a better tree than the incremental
+// updates if many proxies moved far, e.g. after loading a level.
+func (t *DynamicTree) Rebuild() {
+	leaves := make([]int, 0, t.proxies.count)
+	for i := range t.nodes {
+		node := &t.nodes[i]
+		if node.isLeaf() {
+			leaves = append(leaves, i)
+		} else if node.height > 0 {
+			t.release(i)
+		}
+	}
+	if len(leaves) == 0 {
+		return
+	}
+	t.root = t.build(leaves)
+	t.nodes[t.root].parent = nullNode
+}
+
+// Builds a subtree over the leaves by splitting them at the median along the longest axis.
+func (t *DynamicTree) build(leaves []int) int {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	center := func(leaf int) Vector3 {
+		bounds := &t.nodes[leaf].bounds
+		return bounds.Min.Add(bounds.Max)
+	}
+	min, max := center(leaves[0]), center(leaves[0])
+	for _, leaf := range leaves[1:] {
+		min = min.Min(center(leaf))
+		max = max.Max(center(leaf))
+	}
+	extent := max.Sub(min)
+	axis := 0
+	if extent.Y > extent.Get(axis) {
+		axis = 1
+	}
+	if extent.Z > extent.Get(axis) {
+		axis = 2
+	}
+	sort.Slice(leaves, func(i, j int) bool {
+		return center(leaves[i]).Get(axis) < center(leaves[j]).Get(axis)
+	})
+	mid := len(leaves) / 2
+	child1 := t.build(leaves[:mid])
+	child2 := t.build(leaves[mid:])
+
+	parent := t.allocate()
+	node := &t.nodes[parent]
+	node.child1, node.child2 = child1, child2
+	t.nodes[child1].parent = parent
+	t.nodes[child2].parent = parent
+	t.update(parent)
+	return parent
+}
+
+func (t *DynamicTree) fatten(bounds BoundingBox, displacement Vector3) BoundingBox {
+	margin := Vec3(t.margin, t.margin, t.margin)
+	fat := BoundingBox{Min: bounds.Min.Sub(margin), Max: bounds.Max.Add(margin)}
+	d := displacement.Scale(dynamicTreeDisplacementMultiplier)
+	fat.Min = fat.Min.Add(d.Min(Vector3{}))
+	fat.Max = fat.Max.Add(d.Max(Vector3{}))
+	return fat
+}
+
+func (t *DynamicTree) allocate() int {
+	if t.free == nullNode {
+		t.nodes = append(t.nodes, dynamicTreeNode{})
+		return len(t.nodes) - 1
+	}
+	index := t.free
+	t.free = t.nodes[index].parent
+	t.nodes[index] = dynamicTreeNode{}
+	return index
+}
+
+func (t *DynamicTree) release(index int) {
+	t.nodes[index] = dynamicTreeNode{parent: t.free, child1: nullNode, child2: nullNode, height: -1}
+	t.free = index
+}
+
+func (t *DynamicTree) insertLeaf(leaf int) {
+	t.nodes[leaf].child1, t.nodes[leaf].child2 = nullNode, nullNode
+	if t.root == nullNode {
+		t.root = leaf
+		t.nodes[leaf].parent = nullNode
+		return
+	}
+
+	// Find the best sibling by descending into the child with the lower cost.
+	leafBounds := t.nodes[leaf].bounds
+	index := t.root
+	for !t.nodes[index].isLeaf() {
+		node := &t.nodes[index]
+		area := t.cost(&node.bounds)
+		combined := node.bounds
+		combined.Extend(&leafBounds)
+		combinedArea := t.cost(&combined)
+		// The cost of a new parent for this node and the leaf.
+		cost := 2 * combinedArea
+		// The minimum cost of pushing the leaf further down the tree.
+		inheritance := 2 * (combinedArea - area)
+		cost1 := t.descendCost(node.child1, &leafBounds, inheritance)
+		cost2 := t.descendCost(node.child2, &leafBounds, inheritance)
+		if cost < cost1 && cost < cost2 {
+			break
+		}
+		if cost1 < cost2 {
+			index = node.child1
+		} else {
+			index = node.child2
+		}
+	}
+
+	// Create a new parent for the sibling and the leaf.
+	sibling := index
+	oldParent := t.nodes[sibling].parent
+	parent := t.allocate()
+	node := &t.nodes[parent]
+	node.parent = oldParent
+	node.child1, node.child2 = sibling, leaf
+	t.nodes[sibling].parent = parent
+	t.nodes[leaf].parent = parent
+	t.replaceChild(oldParent, sibling, parent)
+	t.fixUpwards(parent)
+}
+
+func (t *DynamicTree) descendCost(child int, leafBounds *BoundingBox, inheritance float32) float32 {
+	node := &t.nodes[child]
+	combined := node.bounds
+	combined.Extend(leafBounds)
+	if node.isLeaf() {
+		return t.cost(&combined) + inheritance
+	}
+	return t.cost(&combined) - t.cost(&node.bounds) + inheritance
+}
+
+func (t *DynamicTree) removeLeaf(leaf int) {
+	if leaf == t.root {
+		t.root = nullNode
+		return
+	}
+	parent := t.nodes[leaf].parent
+	grandParent := t.nodes[parent].parent
+	sibling := t.nodes[parent].child1
+	if sibling == leaf {
+		sibling = t.nodes[parent].child2
+	}
+	t.nodes[sibling].parent = grandParent
+	t.replaceChild(grandParent, parent, sibling)
+	t.release(parent)
+	if grandParent != nullNode {
+		t.fixUpwards(grandParent)
+	}
+}
+
+// Replaces the child of the parent or the root if parent is nullNode.
+func (t *DynamicTree) replaceChild(parent, oldChild, newChild int) {
+	if parent == nullNode {
+		t.root = newChild
+	} else if t.nodes[parent].child1 == oldChild {
+		t.nodes[parent].child1 = newChild
+	} else {
+		t.nodes[parent].child2 = newChild
+	}
+}
+
+// Balances and updates the bounds and heights of the node and its ancestors.
+func (t *DynamicTree) fixUpwards(index int) {
+	for index != nullNode {
+		index = t.balance(index)
+		t.update(index)
+		index = t.nodes[index].parent
+	}
+}
+
+func (t *DynamicTree) update(index int) {
+	node := &t.nodes[index]
+	child1, child2 := &t.nodes[node.child1], &t.nodes[node.child2]
+	node.bounds = child1.bounds
+	node.bounds.Extend(&child2.bounds)
+	node.height = 1 + maxi(child1.height, child2.height)
+}
+
+// Rotates the higher child of the node up if the heights of the children differ by more than one.
+// Returns the index of the node which took the place of the node.
+func (t *DynamicTree) balance(index int) int {
+	node := &t.nodes[index]
+	if node.height < 2 {
+		return index
+	}
+	balance := t.nodes[node.child2].height - t.nodes[node.child1].height
+	if balance > 1 {
+		return t.rotate(index, node.child2)
+	}
+	if balance < -1 {
+		return t.rotate(index, node.child1)
+	}
+	return index
+}
+
+// Moves the child up to the place of its parent, the parent gets the lower subtree of the child.
+func (t *DynamicTree) rotate(parent, child int) int {
+	keep, move := t.nodes[child].child1, t.nodes[child].child2
+	if t.nodes[keep].height < t.nodes[move].height {
+		keep, move = move, keep
+	}
+	grandParent := t.nodes[parent].parent
+	t.nodes[child].parent = grandParent
+	t.replaceChild(grandParent, parent, child)
+	t.nodes[parent].parent = child
+	t.nodes[child].child1, t.nodes[child].child2 = parent, keep
+
+	if t.nodes[parent].child1 == child {
+		t.nodes[parent].child1 = move
+	} else {
+		t.nodes[parent].child2 = move
+	}
+	t.nodes[move].parent = parent
+	t.update(parent)
+	t.update(child)
+	return child
+}
+
+// A DynamicTree for objects in 2D with Rectangle bounds.
+type DynamicTree2 struct {
+	tree *DynamicTree
+}
+
+// Returns an empty tree which enlarges the bounds of the proxies by margin.
+func NewDynamicTree2(margin float32) *DynamicTree2 {
+	return &DynamicTree2{newDynamicTree(margin, boundsPerimeter2)}
+}
+
+// The number of proxies
+func (t *DynamicTree2) Len() int {
+	return t.tree.Len()
+}
+
+// The height of the tree, 0 if it contains a single proxy and -1 if it is empty.
+func (t *DynamicTree2) Height() int {
+	return t.tree.Height()
+}
+
+// Inserts a proxy with the bounds and returns its id. data can be used to associate an object with the proxy.
+func (t *DynamicTree2) Insert(bounds Rectangle, data interface{}) int {
+	return t.tree.Insert(rectangleBounds(&bounds), data)
+}
+
+// Removes the proxy, see itemPool.
+func (t *DynamicTree2) Remove(proxy int) {
+	t.tree.Remove(proxy)
+}
+
+// Updates the bounds of the proxy which moved by displacement since the last update.
+// See DynamicTree.Move.
+func (t *DynamicTree2) Move(proxy int, bounds Rectangle, displacement Vector2) bool {
+	return t.tree.Move(proxy, rectangleBounds(&bounds), Vec3(displacement.X, displacement.Y, 0))
+}
+
+// The enlarged bounds of the proxy.
+func (t *DynamicTree2) FatBounds(proxy int) Rectangle {
+	bounds := t.tree.FatBounds(proxy)
+	return Rectangle{bounds.Min.X, bounds.Min.Y, bounds.Max.X - bounds.Min.X, bounds.Max.Y - bounds.Min.Y}
+}
+
+// The data given when the proxy was inserted.
+func (t *DynamicTree2) Data(proxy int) interface{} {
+	return t.tree.Data(proxy)
+}
+
+// Appends the proxies whose enlarged bounds overlap the rectangle to result.
+func (t *DynamicTree2) Query(rect *Rectangle, result []int) []int {
+	bounds := rectangleBounds(rect)
+	return t.tree.Query(&bounds, result)
+}
+
+// Calls pair for all pairs of proxies whose enlarged bounds overlap, with a < b.
+func (t *DynamicTree2) Pairs(pair func(a, b int)) {
+	t.tree.Pairs(pair)
+}
+
+// Returns the nearest proxy hit by the ray from origin along direction within maxDistance and
+// the distance in multiples of the direction. hit calculates the exact intersection of the ray
+// with the object of a proxy, if it is nil the enlarged bounds of the proxies are used.
+func (t *DynamicTree2) RayCast(origin, direction Vector2, maxDistance float32, hit func(proxy int, origin, direction Vector2) (float32, bool)) (proxy int, distance float32, ok bool) {
+	ray := &Ray{Origin: Vec3(origin.X, origin.Y, 0), Direction: Vec3(direction.X, direction.Y, 0)}
+	var hit3 func(int, *Ray) (float32, bool)
+	if hit != nil {
+		hit3 = func(proxy int, ray *Ray) (float32, bool) { return hit(proxy, origin, direction) }
+	}
+	return t.tree.RayCast(ray, maxDistance, hit3)
+}
+
+// Rebuilds the inner nodes of the tree from scratch, see DynamicTree.Rebuild.
+func (t *DynamicTree2) Rebuild() {
+	t.tree.Rebuild()
+}
+
+func rectangleBounds(rect *Rectangle) BoundingBox {
+	return BoundingBox{Min: Vec3(rect.X, rect.Y, 0), Max: Vec3(rect.X+rect.Width, rect.Y+rect.Height, 0)}
+}
+
+// The cost of a box in the 2D tree is its half perimeter, the z extent is ignored.
+func boundsPerimeter2(box *BoundingBox) float32 {
+	return box.Max.X - box.Min.X + box.Max.Y - box.Min.Y
+}
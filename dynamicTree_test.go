@@ -0,0 +1,254 @@
+package math
+
+import (
+	"math/rand"
+
+	. "launchpad.net/gocheck"
+)
+
+type DynamicTreeTestSuite struct {
+	rnd     *rand.Rand
+	tree    *DynamicTree
+	proxies []int
+	boxes   map[int]BoundingBox
+}
+
+var _ = Suite(&DynamicTreeTestSuite{})
+
+func (s *DynamicTreeTestSuite) SetUpTest(c *C) {
+	s.rnd = rand.New(rand.NewSource(1))
+	s.tree = NewDynamicTree(DefaultDynamicTreeMargin)
+	s.proxies = nil
+	s.boxes = make(map[int]BoundingBox)
+	for i, box := range randomBounds(s.rnd, 300, 5) {
+		proxy := s.tree.Insert(box, i)
+		s.proxies = append(s.proxies, proxy)
+		s.boxes[proxy] = box
+	}
+}
+
+// Checks the links, heights and bounds of all nodes and that the tree is reasonably balanced.
+func checkDynamicTree(c *C, t *DynamicTree) {
+	if t.root == nullNode {
+		c.Assert(t.proxies.count, Equals, 0)
+		return
+	}
+	c.Assert(t.nodes[t.root].parent, Equals, nullNode)
+	leaves := 0
+	var check func(index int)
+	check = func(index int) {
+		node := &t.nodes[index]
+		if node.isLeaf() {
+			c.Assert(t.leaves[node.proxy], Equals, index)
+			leaves++
+			return
+		}
+		c.Assert(t.nodes[node.child1].parent, Equals, index)
+		c.Assert(t.nodes[node.child2].parent, Equals, index)
+		check(node.child1)
+		check(node.child2)
+		c.Assert(node.height, Equals, 1+maxi(t.nodes[node.child1].height, t.nodes[node.child2].height))
+		c.Assert(node.bounds, Equals, *t.nodes[node.child1].bounds.Cpy().Extend(&t.nodes[node.child2].bounds))
+	}
+	check(t.root)
+	c.Assert(leaves, Equals, t.proxies.count)
+	c.Assert(t.Height() <= 4*int(Ceil(Log2(float32(t.proxies.count+1)))), Equals, true)
+}
+
+func (s *DynamicTreeTestSuite) expectedQuery(box *BoundingBox) []int {
+	var expected []int
+	for proxy := range s.boxes {
+		fat := s.tree.FatBounds(proxy)
+		if fat.Overlaps(box) {
+			expected = append(expected, proxy)
+		}
+	}
+	return sortedInts(expected)
+}
+
+func (s *DynamicTreeTestSuite) TestInsert(c *C) {
+	checkDynamicTree(c, s.tree)
+	c.Check(s.tree.Len(), Equals, 300)
+	for proxy, box := range s.boxes {
+		fat := s.tree.FatBounds(proxy)
+		c.Check(fat.Contains(&box), Equals, true)
+	}
+	c.Check(s.tree.Data(s.proxies[5]), Equals, 5)
+
+	query := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	expected := s.expectedQuery(query)
+	c.Assert(len(expected) > 0, Equals, true)
+	c.Check(sortedInts(s.tree.Query(query, nil)), DeepEquals, expected)
+
+	empty := NewDynamicTree(DefaultDynamicTreeMargin)
+	c.Check(empty.Height(), Equals, -1)
+	c.Check(len(empty.Query(query, nil)), Equals, 0)
+}
+
+func (s *DynamicTreeTestSuite) TestMove(c *C) {
+	proxy := s.proxies[0]
+	box := s.boxes[proxy]
+	small := Vec3(0.05, 0, 0)
+	moved := BoundingBox{Min: box.Min.Add(small), Max: box.Max.Add(small)}
+	c.Check(s.tree.Move(proxy, moved, small), Equals, false)
+
+	far := Vec3(10, 0, 0)
+	moved = BoundingBox{Min: box.Min.Add(far), Max: box.Max.Add(far)}
+	c.Check(s.tree.Move(proxy, moved, far), Equals, true)
+	fat := s.tree.FatBounds(proxy)
+	c.Check(fat.Contains(&moved), Equals, true)
+	// The bounds are extended in the direction of the movement.
+	c.Check(fat.Max.X > moved.Max.X+10, Equals, true)
+	c.Check(fat.Min.X, EqualsFloat32, moved.Min.X-DefaultDynamicTreeMargin)
+	s.boxes[proxy] = moved
+
+	for i := 0; i < 1000; i++ {
+		proxy := s.proxies[s.rnd.Intn(len(s.proxies))]
+		d := Vec3(s.rnd.Float32()-0.5, s.rnd.Float32()-0.5, s.rnd.Float32()-0.5).Scale(2)
+		box := s.boxes[proxy]
+		box = BoundingBox{Min: box.Min.Add(d), Max: box.Max.Add(d)}
+		s.tree.Move(proxy, box, d)
+		s.boxes[proxy] = box
+	}
+	checkDynamicTree(c, s.tree)
+	for proxy, box := range s.boxes {
+		fat := s.tree.FatBounds(proxy)
+		c.Check(fat.Contains(&box), Equals, true)
+	}
+	query := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	c.Check(sortedInts(s.tree.Query(query, nil)), DeepEquals, s.expectedQuery(query))
+}
+
+func (s *DynamicTreeTestSuite) TestRemove(c *C) {
+	for i := 0; i < len(s.proxies); i += 2 {
+		proxy := s.proxies[i]
+		s.tree.Remove(proxy)
+		delete(s.boxes, proxy)
+	}
+	checkDynamicTree(c, s.tree)
+	c.Check(s.tree.Len(), Equals, 150)
+	query := &BoundingBox{Min: Vec3(0, 0, 0), Max: Vec3(100, 100, 100)}
+	c.Check(sortedInts(s.tree.Query(query, nil)), DeepEquals, s.expectedQuery(query))
+
+	// The ids of removed proxies are reused.
+	proxy := s.tree.Insert(BoundingBox{Min: Vec3(1, 1, 1), Max: Vec3(2, 2, 2)}, nil)
+	c.Check(proxy < len(s.proxies), Equals, true)
+	c.Check(s.tree.Data(proxy), IsNil)
+	checkDynamicTree(c, s.tree)
+
+	for proxy := range s.boxes {
+		s.tree.Remove(proxy)
+	}
+	c.Check(s.tree.Len(), Equals, 1)
+	c.Check(s.tree.Height(), Equals, 0)
+}
+
+func (s *DynamicTreeTestSuite) TestRemoveStale(c *C) {
+	proxy := s.proxies[0]
+	s.tree.Remove(proxy)
+	c.Check(func() { s.tree.Remove(proxy) }, PanicMatches, "invalid item id")
+	c.Check(func() { s.tree.FatBounds(proxy) }, PanicMatches, "invalid item id")
+	c.Check(func() { s.tree.Data(-1) }, PanicMatches, "invalid item id")
+	c.Check(s.tree.Len(), Equals, 299)
+	checkDynamicTree(c, s.tree)
+}
+
+func (s *DynamicTreeTestSuite) TestPairs(c *C) {
+	expected := make(map[[2]int]bool)
+	for a := range s.boxes {
+		for b := range s.boxes {
+			fatA, fatB := s.tree.FatBounds(a), s.tree.FatBounds(b)
+			if a < b && fatA.Overlaps(&fatB) {
+				expected[[2]int{a, b}] = true
+			}
+		}
+	}
+	c.Assert(len(expected) > 0, Equals, true)
+	pairs := make(map[[2]int]bool)
+	s.tree.Pairs(func(a, b int) {
+		c.Check(a < b, Equals, true)
+		c.Check(pairs[[2]int{a, b}], Equals, false)
+		pairs[[2]int{a, b}] = true
+	})
+	c.Check(pairs, DeepEquals, expected)
+}
+
+func (s *DynamicTreeTestSuite) TestRayCast(c *C) {
+	for i := 0; i < 50; i++ {
+		ray := NewRay(Vec3(-10, s.rnd.Float32()*100, s.rnd.Float32()*100), Vec3(1, s.rnd.Float32()-0.5, s.rnd.Float32()-0.5))
+		nearestDistance := float32(MaxFloat32)
+		for proxy := range s.boxes {
+			fat := s.tree.FatBounds(proxy)
+			if d, ok := IntersectRayBounds(ray, &fat); ok && d < nearestDistance {
+				nearestDistance = d
+			}
+		}
+		_, distance, ok := s.tree.RayCast(ray, MaxFloat32, nil)
+		c.Check(ok, Equals, nearestDistance < MaxFloat32)
+		if ok {
+			c.Check(distance, Equals, nearestDistance)
+		}
+	}
+
+	target := s.proxies[7]
+	box := s.boxes[target]
+	center := box.Min.Add(box.Max).Scale(0.5)
+	ray := NewRay(Vec3(center.X, center.Y, -10), Vec3(0, 0, 1))
+	proxy, distance, ok := s.tree.RayCast(ray, MaxFloat32, func(proxy int, ray *Ray) (float32, bool) {
+		return 1000, proxy == target
+	})
+	c.Check(ok, Equals, true)
+	c.Check(proxy, Equals, target)
+	c.Check(distance, Equals, float32(1000))
+}
+
+func (s *DynamicTreeTestSuite) TestRebuild(c *C) {
+	query := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	expected := s.expectedQuery(query)
+	s.tree.Remove(s.proxies[3])
+	delete(s.boxes, s.proxies[3])
+	s.tree.Rebuild()
+	checkDynamicTree(c, s.tree)
+	c.Check(s.tree.Height(), Equals, int(Ceil(Log2(float32(s.tree.Len())))))
+	c.Check(sortedInts(s.tree.Query(query, nil)), DeepEquals, s.expectedQuery(query))
+	c.Check(len(expected) > 0, Equals, true)
+
+	// The tree stays usable after the rebuild.
+	proxy := s.tree.Insert(BoundingBox{Min: Vec3(1, 1, 1), Max: Vec3(2, 2, 2)}, nil)
+	s.tree.Remove(proxy)
+	checkDynamicTree(c, s.tree)
+}
+
+func (s *DynamicTreeTestSuite) TestDynamicTree2(c *C) {
+	tree := NewDynamicTree2(0.5)
+	a := tree.Insert(Rectangle{0, 0, 2, 2}, "a")
+	b := tree.Insert(Rectangle{2.5, 0, 2, 2}, "b")
+	d := tree.Insert(Rectangle{10, 10, 1, 1}, "d")
+	c.Check(tree.Len(), Equals, 3)
+	c.Check(tree.Data(b), Equals, "b")
+	c.Check(tree.FatBounds(a), Equals, Rectangle{-0.5, -0.5, 3, 3})
+
+	var pairs [][2]int
+	tree.Pairs(func(a, b int) { pairs = append(pairs, [2]int{a, b}) })
+	c.Check(pairs, DeepEquals, [][2]int{{a, b}})
+	c.Check(tree.Query(Rect(9, 9, 1.2, 1.2), nil), DeepEquals, []int{d})
+
+	proxy, distance, ok := tree.RayCast(Vec2(-2, 10.5), Vec2(1, 0), 100, nil)
+	c.Check(ok, Equals, true)
+	c.Check(proxy, Equals, d)
+	c.Check(distance, EqualsFloat32, float32(11.5))
+	_, _, ok = tree.RayCast(Vec2(-2, 10.5), Vec2(1, 0), 100, func(proxy int, origin, direction Vector2) (float32, bool) {
+		return 0, false
+	})
+	c.Check(ok, Equals, false)
+
+	c.Check(tree.Move(d, Rectangle{0, 3, 1, 1}, Vec2(-10, -7)), Equals, true)
+	pairs = pairs[:0]
+	tree.Pairs(func(a, b int) { pairs = append(pairs, [2]int{a, b}) })
+	// The enlarged bounds of d reach back to a but not to b.
+	c.Check(pairs, DeepEquals, [][2]int{{a, b}, {a, d}})
+	tree.Remove(a)
+	tree.Rebuild()
+	c.Check(tree.Height(), Equals, 1)
+	checkDynamicTree(c, tree.tree)
+}
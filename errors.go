@@ -0,0 +1,14 @@
+package math
+
+import (
+	"errors"
+)
+
+var (
+	// Returned when the dimensions of matrices or vectors don't fit the operation.
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+	// Used as panic value when a component or axis index is out of range.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// Used as panic value when an id doesn't identify an item of a spatial container, e.g. after it was removed.
+	ErrInvalidItem = errors.New("invalid item id")
+)
@@ -0,0 +1,6 @@
+package math
+
+// The vector, matrix and shape types have float64 counterparts with a "d" suffix, e.g. Vector3d, Matrix4d,
+// Quaterniond and BoundingBoxd. They provide the same API and are generated from the float32 types by float64gen.go.
+// Conversions in both directions are provided by methods named after the target type, e.g. Vector3.Vector3d
+// and Vector3d.Vector3. Converting to float64 is lossless, converting back rounds to the nearest float32.
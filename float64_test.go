@@ -0,0 +1,128 @@
+package math
+
+import (
+	"math"
+	"reflect"
+	"sort"
+
+	. "launchpad.net/gocheck"
+)
+
+type Float64TestSuite struct{}
+
+var _ = Suite(&Float64TestSuite{})
+
+// Returns the sorted method names of t without the conversion to the other variant
+// and without VecN, VectorN only exists for float32.
+func methodNames(t reflect.Type, conversion string) []string {
+	var names []string
+	for i := 0; i < t.NumMethod(); i++ {
+		if name := t.Method(i).Name; name != conversion && name != "VecN" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// The float64 types have to provide the same methods as the float32 ones, run go generate if this fails.
+func (s *Float64TestSuite) TestSameAPI(c *C) {
+	pairs := [][2]interface{}{
+		{&Vector2{}, &Vector2d{}},
+		{&Vector3{}, &Vector3d{}},
+		{&Vector4{}, &Vector4d{}},
+		{&Matrix3{}, &Matrix3d{}},
+		{&Matrix4{}, &Matrix4d{}},
+		{&Quaternion{}, &Quaterniond{}},
+		{&QuaternionSpline{}, &QuaternionSplined{}},
+		{&DualQuaternion{}, &DualQuaterniond{}},
+		{&BoundingBox{}, &BoundingBoxd{}},
+		{&OrientedBoundingBox{}, &OrientedBoundingBoxd{}},
+		{&Sphere{}, &Sphered{}},
+		{&Plane{}, &Planed{}},
+		{&Ray{}, &Rayd{}},
+		{&Segment{}, &Segmentd{}},
+		{&Frustum{}, &Frustumd{}},
+		{&Rectangle{}, &Rectangled{}},
+		{&Circle{}, &Circled{}},
+		{&Ellipse{}, &Ellipsed{}},
+		{&Polygon{}, &Polygond{}},
+		{&Triangle{}, &Triangled{}},
+		{&Capsule{}, &Capsuled{}},
+		{&Cylinder{}, &Cylinderd{}},
+		{&Cone{}, &Coned{}},
+	}
+	for _, pair := range pairs {
+		t32 := reflect.TypeOf(pair[0])
+		t64 := reflect.TypeOf(pair[1])
+		c.Check(methodNames(t32, t64.Elem().Name()), DeepEquals, methodNames(t64, t32.Elem().Name()), Commentf("%v", t64))
+	}
+}
+
+func (s *Float64TestSuite) TestPrecision(c *C) {
+	// 1e8+1 can't be represented by a float32.
+	v := Vec3d(1e8, 0, 0).Add(Vec3d(1, 0, 0))
+	c.Check(v.X, Equals, float64(1e8+1))
+
+	m := NewTranslationMatrix4d(1e8, 0, 0).Mul(NewTranslationMatrix4d(1, 2, 3))
+	c.Check(m.MulVec3(Vec3d(0.5, 0, 0)), Equals, Vec3d(1e8+1.5, 2, 3))
+}
+
+func (s *Float64TestSuite) TestSingularTolerance(c *C) {
+	// Well conditioned for float64 but not for float32.
+	_, err := NewIdentityMatrix4().Scale(Vec3(1, 1, 1e-8)).Inverse()
+	c.Check(err, Equals, ErrSingularMatrix)
+
+	m := NewIdentityMatrix4d().Scale(Vec3d(1, 1, 1e-8))
+	inv, err := m.Inverse()
+	c.Assert(err, IsNil)
+	c.Check(inv.M33, Equals, float64(1e8))
+	inv, err = m.InverseAffine()
+	c.Assert(err, IsNil)
+	c.Check(inv.M33, Equals, float64(1e8))
+	x, err := m.Solve(Vec4d(1, 2, 3e-8, 4))
+	c.Assert(err, IsNil)
+	c.Check(math.Abs(x.Z-3) < 1e-12, Equals, true)
+	x, err = m.SolveQR(Vec4d(1, 2, 3e-8, 4))
+	c.Assert(err, IsNil)
+	c.Check(math.Abs(x.Z-3) < 1e-12, Equals, true)
+	l, err := m.Cholesky()
+	c.Assert(err, IsNil)
+	c.Check(math.Abs(l.M33-1e-4) < 1e-16, Equals, true)
+
+	_, sigma, _ := NewMatrix3d(1, 0, 0, 0, 1, 0, 0, 0, 1e-9).SVD()
+	c.Check(sigma.Z, Equals, float64(1e-9))
+}
+
+func (s *Float64TestSuite) TestOperations(c *C) {
+	q := NewQuaterniond(0, 0, 0, 1).SetFromAxis(0, 0, 1, math.Pi/2)
+	v := q.Transform(Vec3d(1, 0, 0))
+	c.Check(v.X < 1e-15 && v.X > -1e-15, Equals, true)
+	c.Check(v.Y, Equals, float64(1))
+
+	inv, err := NewRotationMatrix4d(Vec3d(1, 2, 3), 40).Inverse()
+	c.Assert(err, IsNil)
+	c.Check(inv.Matrix4(), Matrix4Check, NewRotationMatrix4(Vec3(1, 2, 3), -40))
+
+	box := NewBoundingBoxd(Vec3d(-1, -1, -1), Vec3d(1, 1, 1))
+	c.Check(box.Max, Equals, Vec3d(1, 1, 1))
+}
+
+func (s *Float64TestSuite) TestConversion(c *C) {
+	v := Vec3(1.5, -2.25, 1e-7)
+	c.Check(v.Vector3d().Vector3(), Equals, v)
+
+	m := NewRotationMatrix4(Vec3(1, 2, 3), 40)
+	c.Check(*m.Matrix4d().Matrix4(), Equals, *m)
+
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7)
+	c.Check(*q.Quaterniond().Quaternion(), Equals, *q)
+
+	f := NewFrustum()
+	fd := f.Frustumd()
+	c.Check(fd.Left == nil, Equals, false)
+	c.Check(len(fd.planePoints), Equals, len(f.planePoints))
+
+	d := Vec3d(0.1, 0.2, 0.3).Vector3()
+	c.Check(d, Equals, Vec3(0.1, 0.2, 0.3))
+}
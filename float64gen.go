@@ -0,0 +1,473 @@
+//go:build ignore
+// +build ignore
+
+// Generates the float64 counterparts of the vector, matrix and shape types.
+// Every declaration of the source files which depends on float32 is copied with a "d" suffix
+// (Vector3 becomes Vector3d, NewMatrix4 becomes NewMatrix4d, ...), float32 becomes float64 and the float32
+// helpers of math.go are replaced with the standard library ones. Declarations which don't depend on float32,
+// like PlaneSide or the float64 linear algebra helpers, are shared by both variants.
+// Additionally conversions between both variants are generated for every struct type.
+//
+// Run "go generate" after changing one of the source files.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"strings"
+)
+
+var sources = []string{
+	"vector2.go",
+	"vector3.go",
+	"vector4.go",
+	"glsl.go",
+	"matrix3.go",
+	"matrix4.go",
+	"linearAlgebra.go",
+	"quaternion.go",
+	"quaternionSpline.go",
+	"dualQuaternion.go",
+	"boundingBox.go",
+	"orientedBoundingBox.go",
+	"sphere.go",
+	"plane.go",
+	"ray.go",
+	"segment.go",
+	"frustum.go",
+	"intersector.go",
+	"rectangle.go",
+	"circle.go",
+	"ellipse.go",
+	"polygon.go",
+	"triangle.go",
+	"capsule.go",
+	"cylinder.go",
+	"cone.go",
+}
+
+// Replacements for the float32 helpers and constants of math.go.
+var mathReplacements = map[string]string{
+	"Abs":              "math.Abs",
+	"Acos":             "math.Acos",
+	"Asin":             "math.Asin",
+	"Atan":             "math.Atan",
+	"Atan2":            "math.Atan2",
+	"Ceil":             "math.Ceil",
+	"Copysign":         "math.Copysign",
+	"Cos":              "math.Cos",
+	"Exp":              "math.Exp",
+	"Floor":            "math.Floor",
+	"Hypot":            "math.Hypot",
+	"Inf":              "math.Inf",
+	"IsInf":            "math.IsInf",
+	"IsNaN":            "math.IsNaN",
+	"Log":              "math.Log",
+	"Max":              "math.Max",
+	"Min":              "math.Min",
+	"Mod":              "math.Mod",
+	"NaN":              "math.NaN",
+	"Pow":              "math.Pow",
+	"Round":            "math.Round",
+	"Sin":              "math.Sin",
+	"Sincos":           "math.Sincos",
+	"Sqrt":             "math.Sqrt",
+	"Tan":              "math.Tan",
+	"Trunc":            "math.Trunc",
+	"Clampf":           "Clampd",
+	"Pi":               "math.Pi",
+	"Pi2":              "(2 * math.Pi)",
+	"RadFull":          "(2 * math.Pi)",
+	"Sqrt2":            "math.Sqrt2",
+	"MaxFloat32":       "math.MaxFloat64",
+	"RadiansToDegrees": "(180 / math.Pi)",
+	"DegreeToRadians":  "(math.Pi / 180)",
+}
+
+// Replacements for constants of the sources which are specific to float32.
+var float64Replacements = map[string]string{
+	"singularTolerance": "singularToleranced",
+}
+
+type source struct {
+	name string
+	file *ast.File
+}
+
+var (
+	fset      = token.NewFileSet()
+	files     []source
+	declared  = map[string]ast.Node{}
+	converted = map[string]bool{}
+	receivers = map[string]receiver{}
+)
+
+type receiver struct {
+	name    string
+	pointer bool
+}
+
+func main() {
+	for _, name := range sources {
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		check(err)
+		files = append(files, source{name, f})
+		for _, decl := range f.Decls {
+			for _, name := range declNames(decl) {
+				declared[name] = decl
+			}
+		}
+	}
+	findConverted()
+	for name := range converted {
+		if _, ok := specOf(declared[name], name).(*ast.TypeSpec); ok {
+			recv, pointer := receiverOf(name)
+			receivers[name] = receiver{recv, pointer}
+		}
+	}
+
+	for _, src := range files {
+		out := generate(src)
+		name := strings.TrimSuffix(src.name, ".go") + "d.go"
+		check(os.WriteFile(name, out, 0644))
+	}
+}
+
+// Returns the package level names declared by decl, methods are not included.
+func declNames(decl ast.Decl) []string {
+	var names []string
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil {
+			names = append(names, d.Name.Name)
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, n := range s.Names {
+					names = append(names, n.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// A declaration has to be converted if it refers to float32 or to another converted declaration.
+func findConverted() {
+	for changed := true; changed; {
+		changed = false
+		for name, node := range declared {
+			if converted[name] {
+				continue
+			}
+			if spec := specOf(node, name); spec != nil {
+				node = spec
+			}
+			if dependsOnFloat32(node) {
+				converted[name] = true
+				changed = true
+			}
+		}
+	}
+}
+
+func specOf(node ast.Node, name string) ast.Node {
+	gen, ok := node.(*ast.GenDecl)
+	if !ok {
+		return nil
+	}
+	for _, spec := range gen.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			if s.Name.Name == name {
+				return s
+			}
+		case *ast.ValueSpec:
+			for _, n := range s.Names {
+				if n.Name == name {
+					return s
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func dependsOnFloat32(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			ast.Inspect(sel.X, func(n ast.Node) bool {
+				found = found || isFloat32Ident(n)
+				return !found
+			})
+			return false
+		}
+		found = found || isFloat32Ident(n)
+		return !found
+	})
+	return found
+}
+
+func isFloat32Ident(n ast.Node) bool {
+	ident, ok := n.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	if ident.Name == "float32" || converted[ident.Name] {
+		return true
+	}
+	_, ok = mathReplacements[ident.Name]
+	return ok && declared[ident.Name] == nil
+}
+
+func isConvertedMethod(decl *ast.FuncDecl) bool {
+	return decl.Recv != nil && converted[receiverType(decl)]
+}
+
+func receiverType(decl *ast.FuncDecl) string {
+	expr := decl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	return expr.(*ast.Ident).Name
+}
+
+func generate(src source) []byte {
+	var body bytes.Buffer
+	var types []*ast.TypeSpec
+	for _, decl := range src.file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if !isConvertedMethod(d) && (d.Recv != nil || !converted[d.Name.Name]) {
+				continue
+			}
+			rename(d)
+			printDecl(&body, src.file, d)
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				continue
+			}
+			var specs []ast.Spec
+			for _, spec := range d.Specs {
+				names := declNames(&ast.GenDecl{Specs: []ast.Spec{spec}})
+				if converted[names[0]] {
+					specs = append(specs, spec)
+					if s, ok := spec.(*ast.TypeSpec); ok {
+						types = append(types, s)
+					}
+				}
+			}
+			if len(specs) == 0 {
+				continue
+			}
+			if len(specs) != len(d.Specs) {
+				d = &ast.GenDecl{Doc: d.Doc, TokPos: d.TokPos, Tok: d.Tok, Lparen: d.Lparen, Specs: specs, Rparen: d.Rparen}
+			}
+			rename(d)
+			printDecl(&body, src.file, d)
+		}
+	}
+	for _, t := range types {
+		writeConversions(&body, t)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by float64gen.go from %s; DO NOT EDIT.\n\npackage math\n\n", src.name)
+	var imports []string
+	for _, imp := range []string{"errors", "math"} {
+		if bytes.Contains(body.Bytes(), []byte(imp+".")) {
+			imports = append(imports, fmt.Sprintf("%q", imp))
+		}
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&out, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	out.Write(body.Bytes())
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, out.String())
+		check(err)
+	}
+	return formatted
+}
+
+func printDecl(out *bytes.Buffer, file *ast.File, decl ast.Decl) {
+	check(printer.Fprint(out, fset, &printer.CommentedNode{Node: decl, Comments: file.Comments}))
+	out.WriteString("\n\n")
+}
+
+// Renames all references in node to their float64 counterparts.
+// Selected fields and methods, declared fields, parameters and composite literal keys are left untouched.
+func rename(node ast.Node) {
+	skip := map[*ast.Ident]bool{}
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.SelectorExpr:
+			skip[x.Sel] = true
+			if pkg, ok := x.X.(*ast.Ident); ok && pkg.Name == "math" {
+				skip[pkg] = true
+				if x.Sel.Name == "MaxFloat32" {
+					x.Sel.Name = "MaxFloat64"
+				}
+			}
+		case *ast.Field:
+			for _, name := range x.Names {
+				skip[name] = true
+			}
+		case *ast.KeyValueExpr:
+			if key, ok := x.Key.(*ast.Ident); ok {
+				skip[key] = true
+			}
+		case *ast.FuncDecl:
+			if x.Recv != nil {
+				skip[x.Name] = true
+			}
+		case *ast.Ident:
+			if skip[x] {
+				return true
+			}
+			switch {
+			case x.Name == "float32":
+				x.Name = "float64"
+			case converted[x.Name]:
+				x.Name += "d"
+			case float64Replacements[x.Name] != "":
+				x.Name = float64Replacements[x.Name]
+			case declared[x.Name] == nil && mathReplacements[x.Name] != "":
+				x.Name = mathReplacements[x.Name]
+			}
+		}
+		return true
+	})
+}
+
+// Writes the conversion methods between the float32 and the float64 variant of the struct type.
+func writeConversions(out *bytes.Buffer, spec *ast.TypeSpec) {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return
+	}
+	name32 := strings.TrimSuffix(spec.Name.Name, "d")
+	name64 := spec.Name.Name
+	recv, pointer := receivers[name32].name, receivers[name32].pointer
+
+	star := ""
+	if pointer {
+		star = "*"
+	}
+	for _, dir := range []struct{ from, to, toType string }{
+		{name32, name64, "float64"},
+		{name64, name32, "float32"},
+	} {
+		fmt.Fprintf(out, "// Converts this %s to a %s.\n", dir.from, dir.to)
+		fmt.Fprintf(out, "func (%s %s%s) %s() %s%s {\n", recv, star, dir.from, dir.to, star, dir.to)
+		if pointer {
+			fmt.Fprintf(out, "return &%s{\n", dir.to)
+		} else {
+			fmt.Fprintf(out, "return %s{\n", dir.to)
+		}
+		var fields []string
+		for _, field := range st.Fields.List {
+			for _, n := range field.Names {
+				fields = append(fields, fmt.Sprintf("%s: %s,", n.Name, convertExpr(field.Type, recv+"."+n.Name, dir.toType)))
+			}
+		}
+		fmt.Fprintf(out, "%s\n}\n}\n\n", strings.Join(fields, "\n"))
+	}
+}
+
+// Returns the expression converting value of the (already renamed) type expr into the variant using toType.
+func convertExpr(expr ast.Expr, value, toType string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch {
+		case t.Name == "float64":
+			return fmt.Sprintf("%s(%s)", toType, value)
+		case isConvertedType(t.Name) && receivers[strings.TrimSuffix(t.Name, "d")].pointer:
+			return fmt.Sprintf("*%s.%s()", value, variantName(t.Name, toType))
+		case isConvertedType(t.Name):
+			return fmt.Sprintf("%s.%s()", value, variantName(t.Name, toType))
+		}
+		return value
+	case *ast.StarExpr:
+		name := variantName(t.X.(*ast.Ident).Name, toType)
+		return fmt.Sprintf("func() *%s { if %s == nil { return nil }; return %s.%s() }()", name, value, value, name)
+	case *ast.ArrayType:
+		elem := variantName(t.Elt.(*ast.Ident).Name, toType)
+		if t.Len != nil {
+			return fmt.Sprintf("func() (out [%s]%s) { for i := range out { out[i] = %s }; return }()",
+				exprString(t.Len), elem, convertExpr(t.Elt, value+"[i]", toType))
+		}
+		return fmt.Sprintf("func() []%s { if %s == nil { return nil }; out := make([]%s, len(%s)); for i := range out { out[i] = %s }; return out }()",
+			elem, value, elem, value, convertExpr(t.Elt, value+"[i]", toType))
+	}
+	panic(fmt.Sprintf("can't convert field %s of type %s", value, exprString(expr)))
+}
+
+func isConvertedType(name64 string) bool {
+	return strings.HasSuffix(name64, "d") && converted[strings.TrimSuffix(name64, "d")]
+}
+
+// Returns the name of the (renamed) type in the variant using toType.
+func variantName(name64, toType string) string {
+	switch {
+	case name64 == "float64":
+		return toType
+	case toType == "float32" && isConvertedType(name64):
+		return strings.TrimSuffix(name64, "d")
+	}
+	return name64
+}
+
+// Returns the most common receiver name of the methods of the type and whether they use pointer receivers.
+// Types with value receivers, like the vectors, are converted by value.
+func receiverOf(typeName string) (string, bool) {
+	counts := map[string]int{}
+	pointer := true
+	for _, src := range files {
+		for _, decl := range src.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || receiverType(fn) != typeName {
+				continue
+			}
+			field := fn.Recv.List[0]
+			if len(field.Names) > 0 {
+				counts[field.Names[0].Name]++
+			}
+			if _, ok := field.Type.(*ast.StarExpr); !ok {
+				pointer = false
+			}
+		}
+	}
+	name := strings.ToLower(typeName[:1])
+	for n, count := range counts {
+		if count > counts[name] || (count == counts[name] && n < name) {
+			name = n
+		}
+	}
+	return name, pointer
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	check(printer.Fprint(&buf, fset, expr))
+	return buf.String()
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
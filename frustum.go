@@ -31,12 +31,12 @@ func NewFrustum() *Frustum {
 		planePoints: make([]Vector3, len(clipSpacePlanePoints))}
 }
 
+// Updates the clipping planes from the inverse of the combined projection and view matrix.
 func (f *Frustum) Update(invProjectionView *Matrix4) {
-	/* TODO
-	for i := range clipSpacePlanePoints {
-		f.planePoints[i] = invProjectionView.Project(clipSpacePlanePoints[i])
+	for i, p := range clipSpacePlanePoints {
+		v := invProjectionView.MulVec4(Vec4(p.X, p.Y, p.Z, 1))
+		f.planePoints[i] = v.Vec3().Scale(1 / v.W)
 	}
-	*/
 	f.Near.Set(f.planePoints[1], f.planePoints[0], f.planePoints[2])
 	f.Far.Set(f.planePoints[4], f.planePoints[5], f.planePoints[7])
 	f.Left.Set(f.planePoints[0], f.planePoints[4], f.planePoints[3])
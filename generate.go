@@ -0,0 +1,6 @@
+package math
+
+// The swizzles and the float64 types are generated, run "go generate" after changing their sources.
+
+//go:generate go run swizzlegen.go
+//go:generate go run float64gen.go
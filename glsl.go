@@ -0,0 +1,234 @@
+package math
+
+// GLSL built-in functions with the semantics of the GLSL specification.
+// The vector variants operate componentwise, Len, Distance and Nor are the GLSL length, distance and normalize.
+
+// Linear blend of x and y with a, like GLSL mix.
+func Mix(x, y, a float32) float32 {
+	return x*(1-a) + y*a
+}
+
+// Returns 0 if x < edge, otherwise 1.
+func Step(edge, x float32) float32 {
+	if x < edge {
+		return 0
+	}
+	return 1
+}
+
+// Hermite interpolation between 0 and 1 when edge0 < x < edge1, 0 below edge0 and 1 above edge1.
+func Smoothstep(edge0, edge1, x float32) float32 {
+	t := Clampf((x-edge0)/(edge1-edge0), 0, 1)
+	return t * t * (3 - 2*t)
+}
+
+// Returns the fractional part x - Floor(x).
+func Fract(x float32) float32 {
+	return x - Floor(x)
+}
+
+// Returns -1, 0 or 1 depending on the sign of x.
+func Sign(x float32) float32 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return x
+}
+
+// Returns x modulo y like GLSL mod: x - y*Floor(x/y).
+// Unlike Mod the result has the sign of y.
+func FloorMod(x, y float32) float32 {
+	return x - y*Floor(x/y)
+}
+
+// Returns 1 / Sqrt(x).
+func InverseSqrt(x float32) float32 {
+	return 1 / Sqrt(x)
+}
+
+// Linear blend of this vector and y with the weights in a.
+func (vec Vector2) Mix(y, a Vector2) Vector2 {
+	return Vec2(Mix(vec.X, y.X, a.X), Mix(vec.Y, y.Y, a.Y))
+}
+
+// Returns 0 for each component lower than the one of edge, otherwise 1.
+func (vec Vector2) Step(edge Vector2) Vector2 {
+	return Vec2(Step(edge.X, vec.X), Step(edge.Y, vec.Y))
+}
+
+// Componentwise Smoothstep
+func (vec Vector2) Smoothstep(edge0, edge1 Vector2) Vector2 {
+	return Vec2(Smoothstep(edge0.X, edge1.X, vec.X), Smoothstep(edge0.Y, edge1.Y, vec.Y))
+}
+
+// Componentwise Fract
+func (vec Vector2) Fract() Vector2 {
+	return Vec2(Fract(vec.X), Fract(vec.Y))
+}
+
+// Componentwise Sign
+func (vec Vector2) Sign() Vector2 {
+	return Vec2(Sign(vec.X), Sign(vec.Y))
+}
+
+// Returns this vector clamped componentwise to the range [min, max].
+func (vec Vector2) Clamp(min, max Vector2) Vector2 {
+	return Vec2(Clampf(vec.X, min.X, max.X), Clampf(vec.Y, min.Y, max.Y))
+}
+
+// Componentwise FloorMod
+func (vec Vector2) Mod(y Vector2) Vector2 {
+	return Vec2(FloorMod(vec.X, y.X), FloorMod(vec.Y, y.Y))
+}
+
+// Componentwise InverseSqrt
+func (vec Vector2) InverseSqrt() Vector2 {
+	return Vec2(InverseSqrt(vec.X), InverseSqrt(vec.Y))
+}
+
+// Returns the reflection of this incident vector at the surface with the normalized normal n.
+func (vec Vector2) Reflect(n Vector2) Vector2 {
+	return vec.Sub(n.Scale(2 * n.Dot(vec)))
+}
+
+// Returns the refraction of this normalized incident vector at the surface with the normalized normal n
+// and the ratio of indices of refraction eta. The result is zero for total internal reflection.
+func (vec Vector2) Refract(n Vector2, eta float32) Vector2 {
+	d := n.Dot(vec)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vector2{}
+	}
+	return vec.Scale(eta).Sub(n.Scale(eta*d + Sqrt(k)))
+}
+
+// Linear blend of this vector and y with the weights in a.
+func (vec Vector3) Mix(y, a Vector3) Vector3 {
+	return Vec3(Mix(vec.X, y.X, a.X), Mix(vec.Y, y.Y, a.Y), Mix(vec.Z, y.Z, a.Z))
+}
+
+// Returns 0 for each component lower than the one of edge, otherwise 1.
+func (vec Vector3) Step(edge Vector3) Vector3 {
+	return Vec3(Step(edge.X, vec.X), Step(edge.Y, vec.Y), Step(edge.Z, vec.Z))
+}
+
+// Componentwise Smoothstep
+func (vec Vector3) Smoothstep(edge0, edge1 Vector3) Vector3 {
+	return Vec3(Smoothstep(edge0.X, edge1.X, vec.X), Smoothstep(edge0.Y, edge1.Y, vec.Y), Smoothstep(edge0.Z, edge1.Z, vec.Z))
+}
+
+// Componentwise Fract
+func (vec Vector3) Fract() Vector3 {
+	return Vec3(Fract(vec.X), Fract(vec.Y), Fract(vec.Z))
+}
+
+// Componentwise Sign
+func (vec Vector3) Sign() Vector3 {
+	return Vec3(Sign(vec.X), Sign(vec.Y), Sign(vec.Z))
+}
+
+// Returns this vector clamped componentwise to the range [min, max].
+func (vec Vector3) Clamp(min, max Vector3) Vector3 {
+	return Vec3(Clampf(vec.X, min.X, max.X), Clampf(vec.Y, min.Y, max.Y), Clampf(vec.Z, min.Z, max.Z))
+}
+
+// Componentwise FloorMod
+func (vec Vector3) Mod(y Vector3) Vector3 {
+	return Vec3(FloorMod(vec.X, y.X), FloorMod(vec.Y, y.Y), FloorMod(vec.Z, y.Z))
+}
+
+// Componentwise InverseSqrt
+func (vec Vector3) InverseSqrt() Vector3 {
+	return Vec3(InverseSqrt(vec.X), InverseSqrt(vec.Y), InverseSqrt(vec.Z))
+}
+
+// Returns the reflection of this incident vector at the surface with the normalized normal n.
+func (vec Vector3) Reflect(n Vector3) Vector3 {
+	return vec.Sub(n.Scale(2 * n.Dot(vec)))
+}
+
+// Returns the refraction of this normalized incident vector at the surface with the normalized normal n
+// and the ratio of indices of refraction eta. The result is zero for total internal reflection.
+func (vec Vector3) Refract(n Vector3, eta float32) Vector3 {
+	d := n.Dot(vec)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vector3{}
+	}
+	return vec.Scale(eta).Sub(n.Scale(eta*d + Sqrt(k)))
+}
+
+// Faceforward returns this vector if n.Dot(i) < 0, otherwise, returns the negative of this vector.
+func (vec Vector3) Faceforward(i, n Vector3) Vector3 {
+	if n.Dot(i) < 0 {
+		return vec
+	}
+	return vec.Invert()
+}
+
+// Linear blend of this vector and y with the weights in a.
+func (vec Vector4) Mix(y, a Vector4) Vector4 {
+	return Vec4(Mix(vec.X, y.X, a.X), Mix(vec.Y, y.Y, a.Y), Mix(vec.Z, y.Z, a.Z), Mix(vec.W, y.W, a.W))
+}
+
+// Returns 0 for each component lower than the one of edge, otherwise 1.
+func (vec Vector4) Step(edge Vector4) Vector4 {
+	return Vec4(Step(edge.X, vec.X), Step(edge.Y, vec.Y), Step(edge.Z, vec.Z), Step(edge.W, vec.W))
+}
+
+// Componentwise Smoothstep
+func (vec Vector4) Smoothstep(edge0, edge1 Vector4) Vector4 {
+	return Vec4(Smoothstep(edge0.X, edge1.X, vec.X), Smoothstep(edge0.Y, edge1.Y, vec.Y), Smoothstep(edge0.Z, edge1.Z, vec.Z), Smoothstep(edge0.W, edge1.W, vec.W))
+}
+
+// Componentwise Fract
+func (vec Vector4) Fract() Vector4 {
+	return Vec4(Fract(vec.X), Fract(vec.Y), Fract(vec.Z), Fract(vec.W))
+}
+
+// Componentwise Sign
+func (vec Vector4) Sign() Vector4 {
+	return Vec4(Sign(vec.X), Sign(vec.Y), Sign(vec.Z), Sign(vec.W))
+}
+
+// Returns this vector clamped componentwise to the range [min, max].
+func (vec Vector4) Clamp(min, max Vector4) Vector4 {
+	return Vec4(Clampf(vec.X, min.X, max.X), Clampf(vec.Y, min.Y, max.Y), Clampf(vec.Z, min.Z, max.Z), Clampf(vec.W, min.W, max.W))
+}
+
+// Componentwise FloorMod
+func (vec Vector4) Mod(y Vector4) Vector4 {
+	return Vec4(FloorMod(vec.X, y.X), FloorMod(vec.Y, y.Y), FloorMod(vec.Z, y.Z), FloorMod(vec.W, y.W))
+}
+
+// Componentwise InverseSqrt
+func (vec Vector4) InverseSqrt() Vector4 {
+	return Vec4(InverseSqrt(vec.X), InverseSqrt(vec.Y), InverseSqrt(vec.Z), InverseSqrt(vec.W))
+}
+
+// Returns the reflection of this incident vector at the surface with the normalized normal n.
+func (vec Vector4) Reflect(n Vector4) Vector4 {
+	return vec.Sub(n.Scale(2 * n.Dot(vec)))
+}
+
+// Returns the refraction of this normalized incident vector at the surface with the normalized normal n
+// and the ratio of indices of refraction eta. The result is zero for total internal reflection.
+func (vec Vector4) Refract(n Vector4, eta float32) Vector4 {
+	d := n.Dot(vec)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vector4{}
+	}
+	return vec.Scale(eta).Sub(n.Scale(eta*d + Sqrt(k)))
+}
+
+// Faceforward returns this vector if n.Dot(i) < 0, otherwise, returns the negative of this vector.
+func (vec Vector4) Faceforward(i, n Vector4) Vector4 {
+	if n.Dot(i) < 0 {
+		return vec
+	}
+	return vec.Invert()
+}
@@ -0,0 +1,100 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type GLSLTestSuite struct{}
+
+var _ = Suite(&GLSLTestSuite{})
+
+func (s *GLSLTestSuite) TestScalar(c *C) {
+	c.Check(Mix(2, 6, 0.25), EqualsFloat32, float32(3))
+	c.Check(Step(1, 0.5), Equals, float32(0))
+	c.Check(Step(1, 1), Equals, float32(1))
+	c.Check(Smoothstep(1, 3, 0), Equals, float32(0))
+	c.Check(Smoothstep(1, 3, 2), EqualsFloat32, float32(0.5))
+	c.Check(Smoothstep(1, 3, 4), Equals, float32(1))
+	c.Check(Fract(-1.25), EqualsFloat32, float32(0.75))
+	c.Check(Sign(-3), Equals, float32(-1))
+	c.Check(Sign(0), Equals, float32(0))
+	c.Check(FloorMod(-1, 3), EqualsFloat32, float32(2))
+	c.Check(FloorMod(1, -3), EqualsFloat32, float32(-2))
+	c.Check(InverseSqrt(4), EqualsFloat32, float32(0.5))
+}
+
+func (s *GLSLTestSuite) TestComponentwise(c *C) {
+	v := Vec4(-1.25, 0.5, 2, 3)
+	c.Check(v.Fract(), Vector4Check, Vec4(0.75, 0.5, 0, 0))
+	c.Check(v.Sign(), Vector4Check, Vec4(-1, 1, 1, 1))
+	c.Check(v.Step(Vec4(0, 1, 1, 3)), Vector4Check, Vec4(0, 0, 1, 1))
+	c.Check(v.Clamp(Vec4(0, 0, 0, 0), Vec4(1, 1, 1, 1)), Vector4Check, Vec4(0, 0.5, 1, 1))
+	c.Check(v.Mix(Vec4(1, 1, 1, 1), Vec4(0, 1, 0.5, 0)), Vector4Check, Vec4(-1.25, 1, 1.5, 3))
+	c.Check(v.Mod(Vec4(1, 1, 3, 2)), Vector4Check, Vec4(0.75, 0.5, 2, 1))
+	c.Check(Vec3(1, 4, 16).InverseSqrt(), Vector3Check, Vec3(1, 0.5, 0.25))
+	c.Check(Vec2(0, 2).Smoothstep(Vec2(1, 1), Vec2(3, 3)), Equals, Vec2(0, 0.5))
+	c.Check(Vec4(1, 2, 2, 4).Len(), EqualsFloat32, float32(5))
+	c.Check(Vec4(1, 2, 2, 4).Nor().Len(), EqualsFloat32, float32(1))
+}
+
+func (s *GLSLTestSuite) TestReflectRefract(c *C) {
+	n := Vec3(0, 1, 0)
+	i := Vec3(1, -1, 0).Nor()
+	c.Check(i.Reflect(n), Vector3Check, Vec3(1, 1, 0).Nor())
+
+	// Without a change of the medium the ray passes straight through.
+	c.Check(i.Refract(n, 1), Vector3Check, i)
+	// Snell's law: sin(out) = eta * sin(in)
+	out := i.Refract(n, 0.5)
+	c.Check(out.Len(), EqualsFloat32, float32(1))
+	c.Check(out.X, EqualsFloat32, 0.5*i.X)
+	// Total internal reflection
+	c.Check(i.Refract(n, 2), Equals, Vector3{})
+
+	c.Check(Vec3(0, 1, 0).Faceforward(Vec3(0, -1, 0), n), Equals, Vec3(0, 1, 0))
+	c.Check(Vec4(0, 1, 0, 0).Faceforward(Vec4(0, 1, 0, 0), Vec4(0, 1, 0, 0)), Equals, Vec4(0, -1, 0, 0))
+}
+
+func (s *GLSLTestSuite) TestPackUnorm4x8(c *C) {
+	packed := PackUnorm4x8(Vec4(0, 1, 0.5, 2))
+	c.Check(packed, Equals, uint32(0xff80ff00))
+	c.Check(UnpackUnorm4x8(packed), Vector4Check, Vec4(0, 1, 128.0/255, 1))
+	c.Check(PackUnorm4x8(Vec4(-1, 0.2, 0, 0)), Equals, uint32(0x3300))
+}
+
+func (s *GLSLTestSuite) TestPackHalf2x16(c *C) {
+	cases := []struct {
+		value float32
+		half  uint16
+	}{
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.1, 0x2e66},
+		{65504, 0x7bff},
+		{65520, 0x7c00},
+		{Inf(1), 0x7c00},
+		{Inf(-1), 0xfc00},
+		{Pow(2, -14), 0x0400},
+		{Pow(2, -24), 0x0001},
+		{Pow(2, -25), 0x0000},
+		{1.5 * Pow(2, -25), 0x0001},
+		{1 + Pow(2, -11), 0x3c00},
+		{1 + 3*Pow(2, -11), 0x3c02},
+	}
+	for _, t := range cases {
+		c.Check(float32ToHalf(t.value), Equals, t.half, Commentf("%v", t.value))
+	}
+	c.Check(IsNaN(halfToFloat32(float32ToHalf(NaN()))), Equals, true)
+
+	// Every half is converted back to itself.
+	for h := 0; h <= 0xffff; h++ {
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			continue
+		}
+		c.Assert(float32ToHalf(halfToFloat32(uint16(h))), Equals, uint16(h))
+	}
+
+	packed := PackHalf2x16(Vec2(1, -2))
+	c.Check(packed, Equals, uint32(0xc0003c00))
+	c.Check(UnpackHalf2x16(packed), Equals, Vec2(1, -2))
+}
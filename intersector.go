@@ -1,24 +1,49 @@
 package math
 
 // Returns whether the given point is inside the triangle.
-// This assumes that the point is on the plane of the triangle.
-// No check is performed that this is the case.
+//
+// Deprecated: use Triangle.ContainsVec.
 func IsPointInTriangle(point, t1, t2, t3 Vector3) bool {
-	v0 := t1.Sub(point)
-	v1 := t2.Sub(point)
-	v2 := t3.Sub(point)
+	t := Triangle{t1, t2, t3}
+	return t.ContainsVec(point)
+}
 
-	ab := v0.Dot(v1)
-	ac := v0.Dot(v2)
-	bc := v1.Dot(v2)
-	cc := v2.Dot(v2)
+// Returns the distance along the ray to the first intersection with the box, in multiples of the ray direction.
+// The distance is 0 if the origin of the ray is inside of the box.
+func IntersectRayBounds(ray *Ray, box *BoundingBox) (float32, bool) {
+	invDir := Vec3(1/ray.Direction.X, 1/ray.Direction.Y, 1/ray.Direction.Z)
+	return intersectRayBounds(ray.Origin, invDir, box, MaxFloat32)
+}
 
-	if bc*ac-cc*ab < 0 {
-		return false
-	}
-	bb := v1.Dot(v1)
-	if ab*bc-ac*bb < 0 {
-		return false
+// Slab test with the precalculated inverse ray direction, only intersections closer than maxDistance are reported.
+// Components of invDir may be infinite for axis parallel rays.
+func intersectRayBounds(origin, invDir Vector3, box *BoundingBox, maxDistance float32) (float32, bool) {
+	var tMin float32
+	tMax := maxDistance
+	for axis := 0; axis < 3; axis++ {
+		o := origin.Get(axis)
+		inv := invDir.Get(axis)
+		t1 := (box.Min.Get(axis) - o) * inv
+		t2 := (box.Max.Get(axis) - o) * inv
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		// The comparisons ignore NaN which occurs for an axis parallel ray on the border of the slab.
+		if t1 > tMin {
+			tMin = t1
+		}
+		if t2 < tMax {
+			tMax = t2
+		}
+		if tMin > tMax {
+			return 0, false
+		}
 	}
-	return true
+	return tMin, true
+}
+
+// Returns whether the sphere and the box overlap.
+func IntersectSphereBounds(sphere *Sphere, box *BoundingBox) bool {
+	closest := sphere.Center.Max(box.Min).Min(box.Max)
+	return closest.Distance2(sphere.Center) <= sphere.Radius*sphere.Radius
 }
@@ -0,0 +1,51 @@
+package math
+
+// The ids and data of the items of a spatial container like DynamicTree, Octree, Quadtree,
+// SpatialHash and SweepAndPrune.
+// An item is identified by the id returned when it was inserted, the ids are small non-negative
+// integers, so containers can keep the rest of their per item state in slices indexed by id.
+// The id of a removed item may be reused by following insertions.
+// Passing the id of a removed item or an id which was never returned panics with ErrInvalidItem.
+type itemPool struct {
+	data  []interface{}
+	live  []bool
+	free  []int
+	count int
+}
+
+// Adds an item with the data and returns its id, which is len(p.data)-1 if the pool had to grow.
+func (p *itemPool) insert(data interface{}) int {
+	var item int
+	if len(p.free) > 0 {
+		item = p.free[len(p.free)-1]
+		p.free = p.free[:len(p.free)-1]
+	} else {
+		p.data = append(p.data, nil)
+		p.live = append(p.live, false)
+		item = len(p.data) - 1
+	}
+	p.data[item] = data
+	p.live[item] = true
+	p.count++
+	return item
+}
+
+func (p *itemPool) remove(item int) {
+	p.check(item)
+	p.data[item] = nil
+	p.live[item] = false
+	p.free = append(p.free, item)
+	p.count--
+}
+
+// Panics with ErrInvalidItem if item isn't the id of a live item.
+func (p *itemPool) check(item int) {
+	if item < 0 || item >= len(p.live) || !p.live[item] {
+		panic(ErrInvalidItem)
+	}
+}
+
+func (p *itemPool) get(item int) interface{} {
+	p.check(item)
+	return p.data[item]
+}
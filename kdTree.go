@@ -0,0 +1,242 @@
+package math
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// The shared implementation of KDTree2 and KDTree3. The tree is implicit: the points of a subtree
+// are a range of indices and the node of the range is its median, which splits the points along the
+// axis with the largest spread. Points of KDTree2 are stored with a z coordinate of 0.
+type kdTree struct {
+	points  []Vector3
+	indices []int
+	// The split axis of the node at each position in indices.
+	axes []uint8
+	dims int
+}
+
+func newKDTree(points []Vector3, dims int) *kdTree {
+	t := &kdTree{points: points, indices: make([]int, len(points)), axes: make([]uint8, len(points)), dims: dims}
+	for i := range t.indices {
+		t.indices[i] = i
+	}
+	t.build(0, len(points))
+	return t
+}
+
+func (t *kdTree) build(lo, hi int) {
+	if hi-lo <= 1 {
+		return
+	}
+	min, max := t.points[t.indices[lo]], t.points[t.indices[lo]]
+	for _, index := range t.indices[lo+1 : hi] {
+		min = min.Min(t.points[index])
+		max = max.Max(t.points[index])
+	}
+	extent := max.Sub(min)
+	axis := 0
+	for i := 1; i < t.dims; i++ {
+		if extent.Get(i) > extent.Get(axis) {
+			axis = i
+		}
+	}
+	mid := (lo + hi) / 2
+	t.selectNth(lo, hi, mid, axis)
+	t.axes[mid] = uint8(axis)
+	t.build(lo, mid)
+	t.build(mid+1, hi)
+}
+
+// Reorders indices[lo:hi] so that the point at position n is the one which would be there if they were
+// sorted along the axis, with no greater points before and no smaller points after it.
+func (t *kdTree) selectNth(lo, hi, n, axis int) {
+	indices := t.indices
+	for hi-lo > 1 {
+		pivot := t.points[indices[(lo+hi)/2]].Get(axis)
+		// Three-way partition into the points less than, equal to and greater than the pivot.
+		lt, i, gt := lo, lo, hi
+		for i < gt {
+			v := t.points[indices[i]].Get(axis)
+			if v < pivot {
+				indices[lt], indices[i] = indices[i], indices[lt]
+				lt++
+				i++
+			} else if v > pivot {
+				gt--
+				indices[i], indices[gt] = indices[gt], indices[i]
+			} else {
+				i++
+			}
+		}
+		if n < lt {
+			hi = lt
+		} else if n >= gt {
+			lo = gt
+		} else {
+			return
+		}
+	}
+}
+
+// Appends the k nearest points ordered by distance. Subtrees are skipped if they can't contain
+// a point nearer than the current kth nearest divided by 1+epsilon.
+func (t *kdTree) nearest(point Vector3, k int, epsilon float32, result []int) []int {
+	if k <= 0 {
+		return result
+	}
+	scale := (1 + epsilon) * (1 + epsilon)
+	neighbours := make(kdNeighbours, 0, k)
+	t.searchNearest(0, len(t.indices), point, k, scale, &neighbours)
+	sort.Sort(sort.Reverse(neighbours))
+	for _, n := range neighbours {
+		result = append(result, n.index)
+	}
+	return result
+}
+
+func (t *kdTree) searchNearest(lo, hi int, point Vector3, k int, scale float32, neighbours *kdNeighbours) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	index := t.indices[mid]
+	p := t.points[index]
+	if d := p.Distance2(point); len(*neighbours) < k {
+		heap.Push(neighbours, kdNeighbour{d, index})
+	} else if d < (*neighbours)[0].distance2 {
+		(*neighbours)[0] = kdNeighbour{d, index}
+		heap.Fix(neighbours, 0)
+	}
+	if hi-lo == 1 {
+		return
+	}
+	axis := int(t.axes[mid])
+	diff := point.Get(axis) - p.Get(axis)
+	if diff < 0 {
+		t.searchNearest(lo, mid, point, k, scale, neighbours)
+		if len(*neighbours) < k || diff*diff*scale < (*neighbours)[0].distance2 {
+			t.searchNearest(mid+1, hi, point, k, scale, neighbours)
+		}
+	} else {
+		t.searchNearest(mid+1, hi, point, k, scale, neighbours)
+		if len(*neighbours) < k || diff*diff*scale < (*neighbours)[0].distance2 {
+			t.searchNearest(lo, mid, point, k, scale, neighbours)
+		}
+	}
+}
+
+func (t *kdTree) inRadius(lo, hi int, point Vector3, radius2 float32, result []int) []int {
+	if lo >= hi {
+		return result
+	}
+	mid := (lo + hi) / 2
+	index := t.indices[mid]
+	p := t.points[index]
+	if p.Distance2(point) <= radius2 {
+		result = append(result, index)
+	}
+	if hi-lo == 1 {
+		return result
+	}
+	axis := int(t.axes[mid])
+	diff := point.Get(axis) - p.Get(axis)
+	if diff <= 0 || diff*diff <= radius2 {
+		result = t.inRadius(lo, mid, point, radius2, result)
+	}
+	if diff >= 0 || diff*diff <= radius2 {
+		result = t.inRadius(mid+1, hi, point, radius2, result)
+	}
+	return result
+}
+
+type kdNeighbour struct {
+	distance2 float32
+	index     int
+}
+
+// A max-heap of the nearest points found so far for container/heap, the farthest point is at the top.
+type kdNeighbours []kdNeighbour
+
+func (n kdNeighbours) Len() int            { return len(n) }
+func (n kdNeighbours) Less(i, j int) bool  { return n[i].distance2 > n[j].distance2 }
+func (n kdNeighbours) Swap(i, j int)       { n[i], n[j] = n[j], n[i] }
+func (n *kdNeighbours) Push(x interface{}) { *n = append(*n, x.(kdNeighbour)) }
+func (n *kdNeighbours) Pop() interface{} {
+	old := *n
+	neighbour := old[len(old)-1]
+	*n = old[:len(old)-1]
+	return neighbour
+}
+
+// A static k-d tree for nearest neighbour searches in a set of points in 3D.
+// Points are identified by their index in the slice given to NewKDTree3.
+// Results include points at the query point itself, e.g. the point whose neighbours are searched.
+type KDTree3 struct {
+	tree *kdTree
+}
+
+// Builds a k-d tree over the points in O(n log n).
+func NewKDTree3(points []Vector3) *KDTree3 {
+	copied := make([]Vector3, len(points))
+	copy(copied, points)
+	return &KDTree3{newKDTree(copied, 3)}
+}
+
+// The number of points
+func (t *KDTree3) Len() int {
+	return len(t.tree.points)
+}
+
+// Appends the indices of the k points nearest to the point to result, ordered by distance.
+func (t *KDTree3) Nearest(point Vector3, k int, result []int) []int {
+	return t.tree.nearest(point, k, 0, result)
+}
+
+// Like Nearest but faster, the distance of the ith result is at most 1+epsilon times the distance
+// of the exact ith nearest point.
+func (t *KDTree3) NearestApprox(point Vector3, k int, epsilon float32, result []int) []int {
+	return t.tree.nearest(point, k, epsilon, result)
+}
+
+// Appends the indices of the points within radius of the point to result, in no particular order.
+func (t *KDTree3) InRadius(point Vector3, radius float32, result []int) []int {
+	return t.tree.inRadius(0, len(t.tree.indices), point, radius*radius, result)
+}
+
+// A static k-d tree for nearest neighbour searches in a set of points in 2D.
+// Points are identified by their index in the slice given to NewKDTree2.
+// Results include points at the query point itself, e.g. the point whose neighbours are searched.
+type KDTree2 struct {
+	tree *kdTree
+}
+
+// Builds a k-d tree over the points in O(n log n).
+func NewKDTree2(points []Vector2) *KDTree2 {
+	points3 := make([]Vector3, len(points))
+	for i, p := range points {
+		points3[i] = Vec3(p.X, p.Y, 0)
+	}
+	return &KDTree2{newKDTree(points3, 2)}
+}
+
+// The number of points
+func (t *KDTree2) Len() int {
+	return len(t.tree.points)
+}
+
+// Appends the indices of the k points nearest to the point to result, ordered by distance.
+func (t *KDTree2) Nearest(point Vector2, k int, result []int) []int {
+	return t.tree.nearest(Vec3(point.X, point.Y, 0), k, 0, result)
+}
+
+// Like Nearest but faster, the distance of the ith result is at most 1+epsilon times the distance
+// of the exact ith nearest point.
+func (t *KDTree2) NearestApprox(point Vector2, k int, epsilon float32, result []int) []int {
+	return t.tree.nearest(Vec3(point.X, point.Y, 0), k, epsilon, result)
+}
+
+// Appends the indices of the points within radius of the point to result, in no particular order.
+func (t *KDTree2) InRadius(point Vector2, radius float32, result []int) []int {
+	return t.tree.inRadius(0, len(t.tree.indices), Vec3(point.X, point.Y, 0), radius*radius, result)
+}
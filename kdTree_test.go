@@ -0,0 +1,120 @@
+package math
+
+import (
+	"math/rand"
+	"sort"
+
+	. "launchpad.net/gocheck"
+)
+
+type KDTreeTestSuite struct {
+	rnd    *rand.Rand
+	points []Vector3
+	tree   *KDTree3
+}
+
+var _ = Suite(&KDTreeTestSuite{})
+
+func (s *KDTreeTestSuite) SetUpTest(c *C) {
+	s.rnd = rand.New(rand.NewSource(1))
+	s.points = make([]Vector3, 1000)
+	for i := range s.points {
+		s.points[i] = Vec3(s.rnd.Float32()*100, s.rnd.Float32()*100, s.rnd.Float32()*10)
+	}
+	// Duplicates and points on a plane must not break the median splits.
+	for i := 0; i < 100; i++ {
+		s.points[i] = s.points[0]
+		s.points[100+i].Z = 5
+	}
+	s.tree = NewKDTree3(s.points)
+}
+
+func (s *KDTreeTestSuite) sortedDistances(point Vector3) []float32 {
+	distances := make([]float32, len(s.points))
+	for i, p := range s.points {
+		distances[i] = p.Distance2(point)
+	}
+	sort.Sort(float32Slice(distances))
+	return distances
+}
+
+func (s *KDTreeTestSuite) TestBuild(c *C) {
+	c.Check(s.tree.Len(), Equals, 1000)
+	// Every node splits its range along its axis.
+	t := s.tree.tree
+	var check func(lo, hi int)
+	check = func(lo, hi int) {
+		if hi-lo <= 1 {
+			return
+		}
+		mid := (lo + hi) / 2
+		axis := int(t.axes[mid])
+		split := t.points[t.indices[mid]].Get(axis)
+		for _, index := range t.indices[lo:mid] {
+			c.Assert(t.points[index].Get(axis) <= split, Equals, true)
+		}
+		for _, index := range t.indices[mid+1 : hi] {
+			c.Assert(t.points[index].Get(axis) >= split, Equals, true)
+		}
+		check(lo, mid)
+		check(mid+1, hi)
+	}
+	check(0, len(t.indices))
+	c.Check(sortedInts(append([]int(nil), t.indices...)), DeepEquals, sortedInts(rand.Perm(1000)))
+}
+
+func (s *KDTreeTestSuite) TestNearest(c *C) {
+	for i := 0; i < 50; i++ {
+		point := Vec3(s.rnd.Float32()*100, s.rnd.Float32()*100, s.rnd.Float32()*10)
+		distances := s.sortedDistances(point)
+		nearest := s.tree.Nearest(point, 10, nil)
+		c.Assert(nearest, HasLen, 10)
+		for j, index := range nearest {
+			c.Check(s.points[index].Distance2(point), Equals, distances[j])
+		}
+	}
+	// All duplicates are found.
+	for _, index := range s.tree.Nearest(s.points[0], 100, nil) {
+		c.Check(s.points[index], Equals, s.points[0])
+	}
+	c.Check(s.tree.Nearest(Vec3(0, 0, 0), 2000, nil), HasLen, 1000)
+	c.Check(s.tree.Nearest(Vec3(0, 0, 0), 0, nil), HasLen, 0)
+	c.Check(NewKDTree3(nil).Nearest(Vec3(0, 0, 0), 1, nil), HasLen, 0)
+}
+
+func (s *KDTreeTestSuite) TestNearestApprox(c *C) {
+	const epsilon = 0.5
+	for i := 0; i < 50; i++ {
+		point := Vec3(s.rnd.Float32()*100, s.rnd.Float32()*100, s.rnd.Float32()*10)
+		distances := s.sortedDistances(point)
+		nearest := s.tree.NearestApprox(point, 5, epsilon, nil)
+		c.Assert(nearest, HasLen, 5)
+		for j, index := range nearest {
+			c.Check(s.points[index].Distance2(point) <= distances[j]*(1+epsilon)*(1+epsilon), Equals, true)
+		}
+	}
+}
+
+func (s *KDTreeTestSuite) TestInRadius(c *C) {
+	for i := 0; i < 50; i++ {
+		point := Vec3(s.rnd.Float32()*100, s.rnd.Float32()*100, s.rnd.Float32()*10)
+		var expected []int
+		for j, p := range s.points {
+			if p.Distance(point) <= 8 {
+				expected = append(expected, j)
+			}
+		}
+		c.Check(sortedInts(s.tree.InRadius(point, 8, nil)), DeepEquals, expected)
+	}
+}
+
+func (s *KDTreeTestSuite) TestKDTree2(c *C) {
+	points := []Vector2{Vec2(0, 0), Vec2(1, 0), Vec2(5, 5), Vec2(0, 2), Vec2(-3, -3), Vec2(1, 0)}
+	tree := NewKDTree2(points)
+	c.Check(tree.Len(), Equals, 6)
+	nearest := tree.Nearest(Vec2(0.9, 0.1), 3, nil)
+	c.Check(sortedInts(nearest[:2]), DeepEquals, []int{1, 5})
+	c.Check(nearest[2], Equals, 0)
+	c.Check(tree.NearestApprox(Vec2(4, 4), 1, 0.1, nil), DeepEquals, []int{2})
+	c.Check(sortedInts(tree.InRadius(Vec2(0, 0), 2, nil)), DeepEquals, []int{0, 1, 3, 5})
+}
@@ -0,0 +1,596 @@
+package math
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// Returned when a matrix is singular (or numerically close to singular).
+	ErrSingularMatrix = errors.New("singular matrix")
+	// Returned by the Cholesky decomposition for matrices that are not symmetric positive definite.
+	ErrNotPositiveDefinite = errors.New("matrix is not symmetric positive definite")
+	// Returned by the affine inverse for matrices whose last row is not (0, 0, 0, 1).
+	ErrNotAffine = errors.New("matrix is not affine")
+)
+
+// Relative tolerance below which a pivot is treated as zero, the epsilon of float32.
+// float64gen.go replaces it with singularToleranced in the float64 variants.
+const singularTolerance = 1.1920929e-07
+
+// Relative tolerance below which a pivot of a float64 matrix is treated as zero, the epsilon of float64.
+const singularToleranced = 2.220446049250313e-16
+
+// Maximum number of sweeps performed by the Jacobi eigenvalue algorithm.
+const jacobiMaxSweeps = 50
+
+// The decompositions below work on dense row-major float64 slices
+// so they can be shared by all matrix types. The relative tolerance epsilon is the one of the matrix type.
+// Element (row r, column c) is stored at index r*cols+c.
+
+// Returns the largest absolute value of the given elements.
+func maxAbs(a []float64) float64 {
+	var max float64
+	for _, v := range a {
+		if v < 0 {
+			v = -v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// Returns the infinity norm (maximum absolute row sum) of the n by n matrix a.
+func normInf(a []float64, n int) float64 {
+	var norm float64
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += math.Abs(a[i*n+j])
+		}
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// Returns the n by n identity matrix.
+func identityRows(n int) []float64 {
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = 1
+	}
+	return a
+}
+
+// LU decomposition with partial pivoting of the n by n matrix a.
+// The unit lower triangular L and the upper triangular U are packed into one matrix.
+// perm[i] is the row of a that ended up in row i and sign is the sign of the permutation.
+func luDecompose(a []float64, n int, epsilon float64) (lu []float64, perm []int, sign float64, err error) {
+	lu = make([]float64, len(a))
+	copy(lu, a)
+	perm = make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sign = 1
+	tolerance := maxAbs(a) * float64(n) * epsilon
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu[i*n+k]) > math.Abs(lu[p*n+k]) {
+				p = i
+			}
+		}
+		if math.Abs(lu[p*n+k]) <= tolerance {
+			return nil, nil, 0, ErrSingularMatrix
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				lu[k*n+j], lu[p*n+j] = lu[p*n+j], lu[k*n+j]
+			}
+			perm[k], perm[p] = perm[p], perm[k]
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			f := lu[i*n+k] / lu[k*n+k]
+			lu[i*n+k] = f
+			for j := k + 1; j < n; j++ {
+				lu[i*n+j] -= f * lu[k*n+j]
+			}
+		}
+	}
+	return lu, perm, sign, nil
+}
+
+// Solves a*x = b given the packed LU decomposition of a.
+func luSolve(lu []float64, perm []int, n int, b []float64) []float64 {
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = b[perm[i]]
+		for j := 0; j < i; j++ {
+			x[i] -= lu[i*n+j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= lu[i*n+j] * x[j]
+		}
+		x[i] /= lu[i*n+i]
+	}
+	return x
+}
+
+// Householder QR decomposition of the rows by cols matrix a.
+// Returns the orthogonal rows by rows matrix Q and the upper triangular rows by cols matrix R.
+func qrDecompose(a []float64, rows, cols int) (q, r []float64) {
+	r = make([]float64, len(a))
+	copy(r, a)
+	q = identityRows(rows)
+	v := make([]float64, rows)
+
+	for k := 0; k < cols && k < rows-1; k++ {
+		var norm float64
+		for i := k; i < rows; i++ {
+			norm += r[i*cols+k] * r[i*cols+k]
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			continue
+		}
+		alpha := -norm
+		if r[k*cols+k] < 0 {
+			alpha = norm
+		}
+		var vLen2 float64
+		for i := k; i < rows; i++ {
+			v[i] = r[i*cols+k]
+			if i == k {
+				v[i] -= alpha
+			}
+			vLen2 += v[i] * v[i]
+		}
+		if vLen2 == 0 {
+			continue
+		}
+		// R = H*R with H = I - 2vv'/v'v
+		for j := 0; j < cols; j++ {
+			var dot float64
+			for i := k; i < rows; i++ {
+				dot += v[i] * r[i*cols+j]
+			}
+			f := 2 * dot / vLen2
+			for i := k; i < rows; i++ {
+				r[i*cols+j] -= f * v[i]
+			}
+		}
+		for i := k + 1; i < rows; i++ {
+			r[i*cols+k] = 0
+		}
+		// Q = Q*H
+		for i := 0; i < rows; i++ {
+			var dot float64
+			for j := k; j < rows; j++ {
+				dot += q[i*rows+j] * v[j]
+			}
+			f := 2 * dot / vLen2
+			for j := k; j < rows; j++ {
+				q[i*rows+j] -= f * v[j]
+			}
+		}
+	}
+	return q, r
+}
+
+// Solves a*x = b in the least squares sense given the QR decomposition of the rows by cols matrix a.
+func qrSolve(q, r []float64, rows, cols int, b []float64, epsilon float64) ([]float64, error) {
+	tolerance := maxAbs(r) * float64(rows) * epsilon
+	// y = Q'b
+	y := make([]float64, cols)
+	for i := 0; i < cols; i++ {
+		for j := 0; j < rows; j++ {
+			y[i] += q[j*rows+i] * b[j]
+		}
+	}
+	x := make([]float64, cols)
+	for i := cols - 1; i >= 0; i-- {
+		if math.Abs(r[i*cols+i]) <= tolerance {
+			return nil, ErrSingularMatrix
+		}
+		x[i] = y[i]
+		for j := i + 1; j < cols; j++ {
+			x[i] -= r[i*cols+j] * x[j]
+		}
+		x[i] /= r[i*cols+i]
+	}
+	return x, nil
+}
+
+// Cholesky decomposition a = L*L' of the symmetric positive definite n by n matrix a.
+// Returns the lower triangular matrix L.
+func choleskyDecompose(a []float64, n int, epsilon float64) ([]float64, error) {
+	tolerance := maxAbs(a) * float64(n) * epsilon
+	l := make([]float64, len(a))
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if math.Abs(a[i*n+j]-a[j*n+i]) > tolerance {
+				return nil, ErrNotPositiveDefinite
+			}
+		}
+	}
+	for j := 0; j < n; j++ {
+		d := a[j*n+j]
+		for k := 0; k < j; k++ {
+			d -= l[j*n+k] * l[j*n+k]
+		}
+		if d <= tolerance {
+			return nil, ErrNotPositiveDefinite
+		}
+		d = math.Sqrt(d)
+		l[j*n+j] = d
+		for i := j + 1; i < n; i++ {
+			s := a[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l[i*n+k] * l[j*n+k]
+			}
+			l[i*n+j] = s / d
+		}
+	}
+	return l, nil
+}
+
+// Solves L*L'*x = b given the Cholesky factor L.
+func choleskySolve(l []float64, n int, b []float64) []float64 {
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = b[i]
+		for k := 0; k < i; k++ {
+			x[i] -= l[i*n+k] * x[k]
+		}
+		x[i] /= l[i*n+i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= l[k*n+i] * x[k]
+		}
+		x[i] /= l[i*n+i]
+	}
+	return x
+}
+
+// Cyclic Jacobi eigenvalue algorithm for the symmetric n by n matrix a.
+// Returns the eigenvalues in descending order and the matching eigenvectors as the columns of vectors.
+func jacobiEigen(a []float64, n int) (values, vectors []float64) {
+	m := make([]float64, len(a))
+	copy(m, a)
+	vectors = identityRows(n)
+
+	for sweep := 0; sweep < jacobiMaxSweeps; sweep++ {
+		var off float64
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += m[p*n+q] * m[p*n+q]
+			}
+		}
+		if off < 1e-30 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := m[p*n+q]
+				if apq == 0 {
+					continue
+				}
+				theta := (m[q*n+q] - m[p*n+p]) / (2 * apq)
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					akp := m[k*n+p]
+					akq := m[k*n+q]
+					m[k*n+p] = c*akp - s*akq
+					m[k*n+q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk := m[p*n+k]
+					aqk := m[q*n+k]
+					m[p*n+k] = c*apk - s*aqk
+					m[q*n+k] = s*apk + c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp := vectors[k*n+p]
+					vkq := vectors[k*n+q]
+					vectors[k*n+p] = c*vkp - s*vkq
+					vectors[k*n+q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	values = make([]float64, n)
+	for i := 0; i < n; i++ {
+		values[i] = m[i*n+i]
+	}
+	// Selection sort in descending order, swapping the eigenvector columns along.
+	for i := 0; i < n; i++ {
+		max := i
+		for j := i + 1; j < n; j++ {
+			if values[j] > values[max] {
+				max = j
+			}
+		}
+		if max != i {
+			values[i], values[max] = values[max], values[i]
+			for k := 0; k < n; k++ {
+				vectors[k*n+i], vectors[k*n+max] = vectors[k*n+max], vectors[k*n+i]
+			}
+		}
+	}
+	return values, vectors
+}
+
+// Returns the row-major elements of this matrix.
+func (m *Matrix3) rows() []float64 {
+	return []float64{
+		float64(m.M11), float64(m.M21), float64(m.M31),
+		float64(m.M12), float64(m.M22), float64(m.M32),
+		float64(m.M13), float64(m.M23), float64(m.M33),
+	}
+}
+
+// Returns a matrix from row-major elements.
+func matrix3FromRows(a []float64) *Matrix3 {
+	return &Matrix3{
+		float32(a[0]), float32(a[3]), float32(a[6]),
+		float32(a[1]), float32(a[4]), float32(a[7]),
+		float32(a[2]), float32(a[5]), float32(a[8]),
+	}
+}
+
+// Returns the row-major elements of this matrix.
+func (m *Matrix4) rows() []float64 {
+	return []float64{
+		float64(m.M11), float64(m.M21), float64(m.M31), float64(m.M41),
+		float64(m.M12), float64(m.M22), float64(m.M32), float64(m.M42),
+		float64(m.M13), float64(m.M23), float64(m.M33), float64(m.M43),
+		float64(m.M14), float64(m.M24), float64(m.M34), float64(m.M44),
+	}
+}
+
+// Returns a matrix from row-major elements.
+func matrix4FromRows(a []float64) *Matrix4 {
+	return &Matrix4{
+		float32(a[0]), float32(a[4]), float32(a[8]), float32(a[12]),
+		float32(a[1]), float32(a[5]), float32(a[9]), float32(a[13]),
+		float32(a[2]), float32(a[6]), float32(a[10]), float32(a[14]),
+		float32(a[3]), float32(a[7]), float32(a[11]), float32(a[15]),
+	}
+}
+
+// Returns the LU decomposition with partial pivoting of this matrix so that P*M = L*U.
+// L is unit lower triangular, U is upper triangular and P is a permutation matrix.
+func (m *Matrix3) LU() (l, u, p *Matrix3, err error) {
+	lu, perm, _, err := luDecompose(m.rows(), 3, singularTolerance)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	la := identityRows(3)
+	ua := make([]float64, 9)
+	pa := make([]float64, 9)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if j < i {
+				la[i*3+j] = lu[i*3+j]
+			} else {
+				ua[i*3+j] = lu[i*3+j]
+			}
+		}
+		pa[i*3+perm[i]] = 1
+	}
+	return matrix3FromRows(la), matrix3FromRows(ua), matrix3FromRows(pa), nil
+}
+
+// Returns the QR decomposition of this matrix so that M = Q*R.
+// Q is orthogonal and R is upper triangular.
+func (m *Matrix3) QR() (q, r *Matrix3) {
+	qa, ra := qrDecompose(m.rows(), 3, 3)
+	return matrix3FromRows(qa), matrix3FromRows(ra)
+}
+
+// Returns the lower triangular matrix L so that M = L*L'.
+// The matrix has to be symmetric positive definite.
+func (m *Matrix3) Cholesky() (*Matrix3, error) {
+	l, err := choleskyDecompose(m.rows(), 3, singularTolerance)
+	if err != nil {
+		return nil, err
+	}
+	return matrix3FromRows(l), nil
+}
+
+// Solves M*x = b for x using the LU decomposition with partial pivoting.
+func (m *Matrix3) Solve(b Vector3) (Vector3, error) {
+	lu, perm, _, err := luDecompose(m.rows(), 3, singularTolerance)
+	if err != nil {
+		return Vector3{}, err
+	}
+	x := luSolve(lu, perm, 3, []float64{float64(b.X), float64(b.Y), float64(b.Z)})
+	return Vec3(float32(x[0]), float32(x[1]), float32(x[2])), nil
+}
+
+// Solves M*x = b for x using the QR decomposition.
+// Slower than Solve but more stable for ill-conditioned matrices.
+func (m *Matrix3) SolveQR(b Vector3) (Vector3, error) {
+	q, r := qrDecompose(m.rows(), 3, 3)
+	x, err := qrSolve(q, r, 3, 3, []float64{float64(b.X), float64(b.Y), float64(b.Z)}, singularTolerance)
+	if err != nil {
+		return Vector3{}, err
+	}
+	return Vec3(float32(x[0]), float32(x[1]), float32(x[2])), nil
+}
+
+// Solves M*x = b for x using the Cholesky decomposition.
+// The matrix has to be symmetric positive definite.
+func (m *Matrix3) SolveCholesky(b Vector3) (Vector3, error) {
+	l, err := choleskyDecompose(m.rows(), 3, singularTolerance)
+	if err != nil {
+		return Vector3{}, err
+	}
+	x := choleskySolve(l, 3, []float64{float64(b.X), float64(b.Y), float64(b.Z)})
+	return Vec3(float32(x[0]), float32(x[1]), float32(x[2])), nil
+}
+
+// Returns the LU decomposition with partial pivoting of this matrix so that P*M = L*U.
+// L is unit lower triangular, U is upper triangular and P is a permutation matrix.
+func (m *Matrix4) LU() (l, u, p *Matrix4, err error) {
+	lu, perm, _, err := luDecompose(m.rows(), 4, singularTolerance)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	la := identityRows(4)
+	ua := make([]float64, 16)
+	pa := make([]float64, 16)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if j < i {
+				la[i*4+j] = lu[i*4+j]
+			} else {
+				ua[i*4+j] = lu[i*4+j]
+			}
+		}
+		pa[i*4+perm[i]] = 1
+	}
+	return matrix4FromRows(la), matrix4FromRows(ua), matrix4FromRows(pa), nil
+}
+
+// Returns the QR decomposition of this matrix so that M = Q*R.
+// Q is orthogonal and R is upper triangular.
+func (m *Matrix4) QR() (q, r *Matrix4) {
+	qa, ra := qrDecompose(m.rows(), 4, 4)
+	return matrix4FromRows(qa), matrix4FromRows(ra)
+}
+
+// Returns the lower triangular matrix L so that M = L*L'.
+// The matrix has to be symmetric positive definite.
+func (m *Matrix4) Cholesky() (*Matrix4, error) {
+	l, err := choleskyDecompose(m.rows(), 4, singularTolerance)
+	if err != nil {
+		return nil, err
+	}
+	return matrix4FromRows(l), nil
+}
+
+// Solves M*x = b for x using the LU decomposition with partial pivoting.
+func (m *Matrix4) Solve(b Vector4) (Vector4, error) {
+	lu, perm, _, err := luDecompose(m.rows(), 4, singularTolerance)
+	if err != nil {
+		return Vector4{}, err
+	}
+	x := luSolve(lu, perm, 4, []float64{float64(b.X), float64(b.Y), float64(b.Z), float64(b.W)})
+	return Vec4(float32(x[0]), float32(x[1]), float32(x[2]), float32(x[3])), nil
+}
+
+// Solves M*x = b for x using the QR decomposition.
+// Slower than Solve but more stable for ill-conditioned matrices.
+func (m *Matrix4) SolveQR(b Vector4) (Vector4, error) {
+	q, r := qrDecompose(m.rows(), 4, 4)
+	x, err := qrSolve(q, r, 4, 4, []float64{float64(b.X), float64(b.Y), float64(b.Z), float64(b.W)}, singularTolerance)
+	if err != nil {
+		return Vector4{}, err
+	}
+	return Vec4(float32(x[0]), float32(x[1]), float32(x[2]), float32(x[3])), nil
+}
+
+// Solves M*x = b for x using the Cholesky decomposition.
+// The matrix has to be symmetric positive definite.
+func (m *Matrix4) SolveCholesky(b Vector4) (Vector4, error) {
+	l, err := choleskyDecompose(m.rows(), 4, singularTolerance)
+	if err != nil {
+		return Vector4{}, err
+	}
+	x := choleskySolve(l, 4, []float64{float64(b.X), float64(b.Y), float64(b.Z), float64(b.W)})
+	return Vec4(float32(x[0]), float32(x[1]), float32(x[2]), float32(x[3])), nil
+}
+
+// Returns the eigenvalues in descending order and the matching unit eigenvectors of this symmetric matrix.
+// Uses the Jacobi eigenvalue algorithm, the matrix is assumed to be symmetric.
+func (m *Matrix3) SymmetricEigen() (values Vector3, vectors [3]Vector3) {
+	vals, vecs := jacobiEigen(m.rows(), 3)
+	values = Vec3(float32(vals[0]), float32(vals[1]), float32(vals[2]))
+	for i := 0; i < 3; i++ {
+		vectors[i] = Vec3(float32(vecs[i]), float32(vecs[3+i]), float32(vecs[6+i]))
+	}
+	return values, vectors
+}
+
+// Returns the singular value decomposition of this matrix so that M = U*S*V'.
+// U and V are orthogonal and S is the diagonal matrix of the singular values in descending order.
+func (m *Matrix3) SVD() (u *Matrix3, s Vector3, v *Matrix3) {
+	a := m.rows()
+	ata := make([]float64, 9)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				ata[i*3+j] += a[k*3+i] * a[k*3+j]
+			}
+		}
+	}
+	values, va := jacobiEigen(ata, 3)
+
+	sigma := make([]float64, 3)
+	ua := make([]float64, 9)
+	tolerance := math.Sqrt(math.Max(values[0], 0)) * 3 * singularTolerance
+	for j := 0; j < 3; j++ {
+		sigma[j] = math.Sqrt(math.Max(values[j], 0))
+		if sigma[j] <= tolerance {
+			sigma[j] = 0
+			continue
+		}
+		// u_j = A*v_j / sigma_j
+		for i := 0; i < 3; i++ {
+			var sum float64
+			for k := 0; k < 3; k++ {
+				sum += a[i*3+k] * va[k*3+j]
+			}
+			ua[i*3+j] = sum / sigma[j]
+		}
+	}
+	completeOrthonormalColumns(ua, sigma)
+
+	return matrix3FromRows(ua), Vec3(float32(sigma[0]), float32(sigma[1]), float32(sigma[2])), matrix3FromRows(va)
+}
+
+// Fills the columns of the row-major 3 by 3 matrix a whose singular value is zero
+// so that all columns form an orthonormal basis.
+func completeOrthonormalColumns(a, sigma []float64) {
+	column := func(j int) Vector3 {
+		return Vec3(float32(a[j]), float32(a[3+j]), float32(a[6+j]))
+	}
+	setColumn := func(j int, v Vector3) {
+		a[j], a[3+j], a[6+j] = float64(v.X), float64(v.Y), float64(v.Z)
+	}
+	// Singular values are sorted so zero ones are always at the end.
+	switch {
+	case sigma[0] == 0:
+		setColumn(0, Vec3(1, 0, 0))
+		setColumn(1, Vec3(0, 1, 0))
+		setColumn(2, Vec3(0, 0, 1))
+	case sigma[1] == 0:
+		u0 := column(0)
+		axis := Vec3(1, 0, 0)
+		if Abs(u0.X) > 0.5 {
+			axis = Vec3(0, 1, 0)
+		}
+		u1 := u0.Cross(axis).Nor()
+		setColumn(1, u1)
+		setColumn(2, u0.Cross(u1))
+	case sigma[2] == 0:
+		setColumn(2, column(0).Cross(column(1)).Nor())
+	}
+}
@@ -0,0 +1,207 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type SolveMatrix3TestValue struct {
+	Matrix   *Matrix3
+	Value    Vector3
+	Expected Vector3
+}
+
+type SolveMatrix4TestValue struct {
+	Matrix   *Matrix4
+	Value    Vector4
+	Expected Vector4
+}
+
+type LinearAlgebraTestSuite struct {
+	solve3TestTable    []SolveMatrix3TestValue
+	solve4TestTable    []SolveMatrix4TestValue
+	cholesky3TestTable []SolveMatrix3TestValue
+	cholesky4TestTable []SolveMatrix4TestValue
+	singularTestTable  []*Matrix3
+}
+
+var _ = Suite(&LinearAlgebraTestSuite{})
+
+func (test *LinearAlgebraTestSuite) SetUpTest(c *C) {
+	test.solve3TestTable = []SolveMatrix3TestValue{
+		SolveMatrix3TestValue{
+			NewMatrix3(2, 1, 1, 1, 3, 4, 1, 2, 5),
+			Vec3(7, 13, 24),
+			Vec3(1, 2, 3),
+		},
+		SolveMatrix3TestValue{
+			NewMatrix3(0, 1, 2, 3, 1, 1, 2, 2, 1),
+			Vec3(12, 9, 7),
+			Vec3(1, 2, 3),
+		},
+	}
+	test.solve4TestTable = []SolveMatrix4TestValue{
+		SolveMatrix4TestValue{
+			&Matrix4{4, 1, 2, 1, 1, 5, 1, 2, 2, 1, 6, 1, 1, 2, 1, 7},
+			Vec4(16, 22, 26, 36),
+			Vec4(1, 2, 3, 4),
+		},
+		SolveMatrix4TestValue{
+			&Matrix4{0, 1, 0, 0, 1, 0, 0, 0, 0, 0, 2, 1, 1, 1, 1, 1},
+			Vec4(6, 5, 10, 7),
+			Vec4(1, 2, 3, 4),
+		},
+	}
+	test.cholesky3TestTable = []SolveMatrix3TestValue{
+		SolveMatrix3TestValue{
+			NewMatrix3(4, 1, 2, 1, 3, 1, 2, 1, 5),
+			Vec3(12, 10, 19),
+			Vec3(1, 2, 3),
+		},
+	}
+	test.cholesky4TestTable = []SolveMatrix4TestValue{
+		SolveMatrix4TestValue{
+			&Matrix4{4, 1, 2, 1, 1, 5, 1, 2, 2, 1, 6, 1, 1, 2, 1, 7},
+			Vec4(16, 22, 26, 36),
+			Vec4(1, 2, 3, 4),
+		},
+	}
+	test.singularTestTable = []*Matrix3{
+		NewMatrix3(1, 2, 3, 2, 4, 6, 1, 1, 1),
+		NewMatrix3(0, 0, 0, 1, 2, 3, 4, 5, 6),
+	}
+}
+
+func (test *LinearAlgebraTestSuite) TestSolveMatrix3(c *C) {
+	for _, value := range test.solve3TestTable {
+		x, err := value.Matrix.Solve(value.Value)
+		c.Check(err, IsNil)
+		c.Check(x, Vector3Check, value.Expected)
+
+		x, err = value.Matrix.SolveQR(value.Value)
+		c.Check(err, IsNil)
+		c.Check(x, Vector3Check, value.Expected)
+	}
+}
+
+func (test *LinearAlgebraTestSuite) TestSolveMatrix4(c *C) {
+	for _, value := range test.solve4TestTable {
+		x, err := value.Matrix.Solve(value.Value)
+		c.Check(err, IsNil)
+		c.Check(x, Vector4Check, value.Expected)
+
+		x, err = value.Matrix.SolveQR(value.Value)
+		c.Check(err, IsNil)
+		c.Check(x, Vector4Check, value.Expected)
+	}
+}
+
+func (test *LinearAlgebraTestSuite) TestSolveCholesky(c *C) {
+	for _, value := range test.cholesky3TestTable {
+		x, err := value.Matrix.SolveCholesky(value.Value)
+		c.Check(err, IsNil)
+		c.Check(x, Vector3Check, value.Expected)
+
+		l, err := value.Matrix.Cholesky()
+		c.Check(err, IsNil)
+		c.Check(l.Mul(l.Transpose()), Matrix3Check, value.Matrix)
+	}
+	for _, value := range test.cholesky4TestTable {
+		x, err := value.Matrix.SolveCholesky(value.Value)
+		c.Check(err, IsNil)
+		c.Check(x, Vector4Check, value.Expected)
+	}
+
+	_, err := NewMatrix3(2, 1, 1, 1, 3, 4, 1, 2, 5).Cholesky()
+	c.Check(err, Equals, ErrNotPositiveDefinite)
+	_, err = NewMatrix3(-1, 0, 0, 0, 1, 0, 0, 0, 1).Cholesky()
+	c.Check(err, Equals, ErrNotPositiveDefinite)
+}
+
+func (test *LinearAlgebraTestSuite) TestSolveSingular(c *C) {
+	for _, m := range test.singularTestTable {
+		_, err := m.Solve(Vec3(1, 2, 3))
+		c.Check(err, Equals, ErrSingularMatrix)
+		_, err = m.SolveQR(Vec3(1, 2, 3))
+		c.Check(err, Equals, ErrSingularMatrix)
+		_, _, _, err = m.LU()
+		c.Check(err, Equals, ErrSingularMatrix)
+	}
+}
+
+func (test *LinearAlgebraTestSuite) TestLU(c *C) {
+	m := NewMatrix3(2, 1, 1, 1, 3, 4, 1, 2, 5)
+	l, u, p, err := m.LU()
+	c.Check(err, IsNil)
+	c.Check(l.Mul(u), Matrix3Check, p.Mul(m))
+	c.Check(l.M11, Equals, float32(1))
+	c.Check(l.M22, Equals, float32(1))
+	c.Check(l.M33, Equals, float32(1))
+	c.Check(u.M12, Equals, float32(0))
+	c.Check(u.M13, Equals, float32(0))
+	c.Check(u.M23, Equals, float32(0))
+
+	m4 := &Matrix4{4, 1, 2, 1, 1, 5, 1, 2, 2, 1, 6, 1, 1, 2, 1, 7}
+	l4, u4, p4, err := m4.LU()
+	c.Check(err, IsNil)
+	c.Check(l4.Mul(u4), Matrix4Check, p4.Mul(m4))
+}
+
+func (test *LinearAlgebraTestSuite) TestQR(c *C) {
+	m := NewMatrix3(2, 1, 1, 1, 3, 4, 1, 2, 5)
+	q, r := m.QR()
+	c.Check(q.Mul(r), Matrix3Check, m)
+	c.Check(isOrthogonalMatrix3(q), Equals, true)
+	c.Check(r.M12, Equals, float32(0))
+	c.Check(r.M13, Equals, float32(0))
+	c.Check(r.M23, Equals, float32(0))
+
+	m4 := &Matrix4{4, 1, 2, 1, 1, 5, 1, 2, 2, 1, 6, 1, 1, 2, 1, 7}
+	q4, r4 := m4.QR()
+	c.Check(q4.Mul(r4), Matrix4Check, m4)
+}
+
+func (test *LinearAlgebraTestSuite) TestSymmetricEigen(c *C) {
+	m := NewMatrix3(2, 1, 0, 1, 2, 0, 0, 0, 5)
+	values, vectors := m.SymmetricEigen()
+	c.Check(values, Vector3Check, Vec3(5, 3, 1))
+	for _, v := range vectors {
+		c.Check(v.Len(), EqualsFloat32, float32(1))
+	}
+
+	m = NewMatrix3(4, 1, 2, 1, 3, 1, 2, 1, 5)
+	values, vectors = m.SymmetricEigen()
+	v := &Matrix3{
+		vectors[0].X, vectors[0].Y, vectors[0].Z,
+		vectors[1].X, vectors[1].Y, vectors[1].Z,
+		vectors[2].X, vectors[2].Y, vectors[2].Z,
+	}
+	d := &Matrix3{M11: values.X, M22: values.Y, M33: values.Z}
+	c.Check(v.Mul(d).Mul(v.Transpose()), Matrix3Check, m)
+}
+
+func (test *LinearAlgebraTestSuite) TestSVD(c *C) {
+	m := NewMatrix3(2, 1, 1, 1, 3, 4, 1, 2, 5)
+	u, s, v := m.SVD()
+	d := &Matrix3{M11: s.X, M22: s.Y, M33: s.Z}
+	c.Check(u.Mul(d).Mul(v.Transpose()), Matrix3Check, m)
+	c.Check(s.X >= s.Y && s.Y >= s.Z, Equals, true)
+
+	// Rank deficient matrix, U still has to be orthogonal.
+	m = NewMatrix3(1, 2, 3, 2, 4, 6, 1, 1, 1)
+	u, s, v = m.SVD()
+	c.Check(s.Z, Equals, float32(0))
+	c.Check(isOrthogonalMatrix3(u), Equals, true)
+	c.Check(isOrthogonalMatrix3(v), Equals, true)
+}
+
+// Whether m*m' is the identity matrix, NearlyEqualFloat32 can't be used to compare against zero.
+func isOrthogonalMatrix3(m *Matrix3) bool {
+	p := m.Mul(m.Transpose())
+	i := NewIdentityMatrix3()
+	for j, v := range p.ToArray() {
+		if Abs(v-i.ToArray()[j]) > 0.00001 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,234 @@
+package math
+
+import (
+	"container/heap"
+)
+
+const (
+	// The default maximum depth of an Octree or Quadtree.
+	DefaultLooseTreeMaxDepth = 8
+	// The default factor by which the bounds of the nodes of an Octree or Quadtree are enlarged.
+	DefaultLooseness = 2
+)
+
+// The shared implementation of Octree and Quadtree. A quadtree is an octree with dims 2
+// where all z coordinates are 0.
+type looseTree struct {
+	root      *looseTreeNode
+	dims      int
+	maxDepth  int
+	looseness float32
+	pool      itemPool
+	items     []looseTreeItem
+}
+
+type looseTreeNode struct {
+	center Vector3
+	// Half the edge length of the node without looseness.
+	halfSize float32
+	depth    int
+	parent   *looseTreeNode
+	// The index in the children of the parent.
+	index    int
+	children []*looseTreeNode
+	items    []int
+	// The number of items in the subtree, empty subtrees are removed.
+	count int
+}
+
+type looseTreeItem struct {
+	bounds BoundingBox
+	node   *looseTreeNode
+	// The index in the items of the node.
+	index int
+}
+
+func newLooseTree(bounds *BoundingBox, dims, maxDepth int, looseness float32) *looseTree {
+	if maxDepth < 0 {
+		maxDepth = 0
+	}
+	if looseness < 1 {
+		looseness = 1
+	}
+	size := bounds.Max.Sub(bounds.Min)
+	halfSize := Max(size.X, size.Y)
+	if dims == 3 {
+		halfSize = Max(halfSize, size.Z)
+	}
+	root := &looseTreeNode{center: bounds.Min.Add(bounds.Max).Scale(0.5), halfSize: halfSize / 2}
+	return &looseTree{root: root, dims: dims, maxDepth: maxDepth, looseness: looseness}
+}
+
+func (t *looseTree) insert(bounds *BoundingBox, data interface{}) int {
+	item := t.pool.insert(data)
+	if item == len(t.items) {
+		t.items = append(t.items, looseTreeItem{})
+	}
+	t.items[item] = looseTreeItem{bounds: *bounds}
+	t.add(item, t.locate(bounds))
+	return item
+}
+
+func (t *looseTree) remove(item int) {
+	t.pool.remove(item)
+	t.detach(item)
+	t.items[item] = looseTreeItem{}
+}
+
+func (t *looseTree) update(item int, bounds *BoundingBox) {
+	t.pool.check(item)
+	t.items[item].bounds = *bounds
+	node := t.locate(bounds)
+	if node != t.items[item].node {
+		// Add to the new node first, so that it isn't removed if the old node becomes empty.
+		oldNode, oldIndex := t.items[item].node, t.items[item].index
+		t.add(item, node)
+		t.detachFrom(item, oldNode, oldIndex)
+	}
+}
+
+// Returns the deepest node whose loose bounds contain the bounds, creating it if necessary.
+// Bounds which don't fit into the root are stored in the root.
+func (t *looseTree) locate(bounds *BoundingBox) *looseTreeNode {
+	node := t.root
+	center := bounds.Min.Add(bounds.Max).Scale(0.5)
+	for node.depth < t.maxDepth {
+		index := 0
+		offset := Vec3(-1, -1, -1)
+		for axis := 0; axis < t.dims; axis++ {
+			if center.Get(axis) >= node.center.Get(axis) {
+				index |= 1 << uint(axis)
+				offset.SetIdx(axis, 1)
+			}
+		}
+		if t.dims == 2 {
+			offset.Z = 0
+		}
+		childCenter := node.center.Add(offset.Scale(node.halfSize / 2))
+		loose := t.looseBounds(childCenter, node.halfSize/2)
+		if !loose.Contains(bounds) {
+			break
+		}
+		if node.children == nil {
+			node.children = make([]*looseTreeNode, 1<<uint(t.dims))
+		}
+		if node.children[index] == nil {
+			node.children[index] = &looseTreeNode{center: childCenter, halfSize: node.halfSize / 2, depth: node.depth + 1, parent: node, index: index}
+		}
+		node = node.children[index]
+	}
+	return node
+}
+
+func (t *looseTree) bounds(item int) *BoundingBox {
+	t.pool.check(item)
+	return &t.items[item].bounds
+}
+
+func (t *looseTree) looseBounds(center Vector3, halfSize float32) BoundingBox {
+	h := halfSize * t.looseness
+	extent := Vec3(h, h, h)
+	if t.dims == 2 {
+		extent.Z = 0
+	}
+	return BoundingBox{Min: center.Sub(extent), Max: center.Add(extent)}
+}
+
+func (t *looseTree) add(item int, node *looseTreeNode) {
+	t.items[item].node = node
+	t.items[item].index = len(node.items)
+	node.items = append(node.items, item)
+	for ; node != nil; node = node.parent {
+		node.count++
+	}
+}
+
+// Removes the item from its node and removes the nodes which became empty.
+func (t *looseTree) detach(item int) {
+	t.detachFrom(item, t.items[item].node, t.items[item].index)
+}
+
+func (t *looseTree) detachFrom(item int, node *looseTreeNode, index int) {
+	last := node.items[len(node.items)-1]
+	node.items[index] = last
+	if last != item {
+		t.items[last].index = index
+	}
+	node.items = node.items[:len(node.items)-1]
+	for ; node != nil; node = node.parent {
+		node.count--
+		if node.count == 0 && node.parent != nil {
+			node.parent.children[node.index] = nil
+		}
+	}
+}
+
+func (t *looseTree) query(node *looseTreeNode, overlaps func(bounds *BoundingBox) bool, result []int) []int {
+	// The root also contains the items outside of its bounds.
+	if node != t.root {
+		loose := t.looseBounds(node.center, node.halfSize)
+		if !overlaps(&loose) {
+			return result
+		}
+	}
+	for _, item := range node.items {
+		if overlaps(&t.items[item].bounds) {
+			result = append(result, item)
+		}
+	}
+	for _, child := range node.children {
+		if child != nil {
+			result = t.query(child, overlaps, result)
+		}
+	}
+	return result
+}
+
+// Appends the k items nearest to the point to result, ordered by the distance of their bounds to the point.
+func (t *looseTree) nearest(point Vector3, k int, result []int) []int {
+	queue := &looseTreeQueue{{node: t.root, item: -1}}
+	for found := 0; found < k && queue.Len() > 0; {
+		entry := heap.Pop(queue).(looseTreeEntry)
+		if entry.node == nil {
+			result = append(result, entry.item)
+			found++
+			continue
+		}
+		for _, item := range entry.node.items {
+			heap.Push(queue, looseTreeEntry{distance2: t.items[item].bounds.ClosestPoint(point).Distance2(point), item: item})
+		}
+		for _, child := range entry.node.children {
+			if child != nil {
+				loose := t.looseBounds(child.center, child.halfSize)
+				heap.Push(queue, looseTreeEntry{distance2: loose.ClosestPoint(point).Distance2(point), node: child, item: -1})
+			}
+		}
+	}
+	return result
+}
+
+// A node or an item in the queue of a nearest neighbour search.
+type looseTreeEntry struct {
+	distance2 float32
+	node      *looseTreeNode
+	item      int
+}
+
+// A priority queue for container/heap ordered by distance, items come before nodes at the same distance.
+type looseTreeQueue []looseTreeEntry
+
+func (q looseTreeQueue) Len() int { return len(q) }
+func (q looseTreeQueue) Less(i, j int) bool {
+	if q[i].distance2 == q[j].distance2 {
+		return q[i].node == nil && q[j].node != nil
+	}
+	return q[i].distance2 < q[j].distance2
+}
+func (q looseTreeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *looseTreeQueue) Push(x interface{}) { *q = append(*q, x.(looseTreeEntry)) }
+func (q *looseTreeQueue) Pop() interface{} {
+	old := *q
+	entry := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return entry
+}
@@ -61,14 +61,15 @@ func Log1p(x float32) float32 { return float32(math.Log1p(float64(x))) }
 func Log2(x float32) float32  { return float32(math.Log2(float64(x))) }
 func Logb(x float32) float32  { return float32(math.Logb(float64(x))) }
 
-//func Max(x, y float32) float32 { return float32(math.Max(float64(x), float64(y))) }
-//func Min(x, y float32) float32 { return float32(math.Min(float64(x), float64(y))) }
+// func Max(x, y float32) float32 { return float32(math.Max(float64(x), float64(y))) }
+// func Min(x, y float32) float32 { return float32(math.Min(float64(x), float64(y))) }
 func Mod(x, y float32) float32          { return float32(math.Mod(float64(x), float64(y))) }
 func Modf(f float32) (float32, float32) { x, y := math.Modf(float64(f)); return float32(x), float32(y) }
 func NaN() float32                      { return float32(math.NaN()) }
 func Nextafter(x, y float32) float32    { return float32(math.Nextafter(float64(x), float64(y))) }
 func Pow(x, y float32) float32          { return float32(math.Pow(float64(x), float64(y))) }
 func Pow10(e int) float32               { return float32(math.Pow10(e)) }
+func Round(x float32) float32           { return float32(math.Round(float64(x))) }
 func Remainder(x, y float32) float32    { return float32(math.Remainder(float64(x), float64(y))) }
 func Signbit(x float32) bool            { return math.Signbit(float64(x)) }
 func Sin(x float32) float32             { return float32(math.Sin(float64(x))) }
@@ -143,3 +144,13 @@ func Max(a, b float32) float32 {
 	}
 	return b
 }
+
+func Clampd(value, min, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -2,6 +2,7 @@ package math
 
 import (
 	"errors"
+	"math"
 )
 
 type Matrix4 struct {
@@ -24,6 +25,7 @@ func NewIdentityMatrix4() *Matrix4 {
 	}
 }
 
+// Returns a perspective projection matrix with the vertical field of view fovy in degrees.
 func NewPerspectiveMatrix4(fovy, aspectRatio, near, far float32) *Matrix4 {
 	fovy = fovy * DegreeToRadians
 	nmf := near - far
@@ -36,6 +38,11 @@ func NewPerspectiveMatrix4(fovy, aspectRatio, near, far float32) *Matrix4 {
 	}
 }
 
+// Returns a perspective projection matrix with the vertical field of view fovy.
+func NewPerspectiveMatrix4Angle(fovy Angle, aspectRatio, near, far float32) *Matrix4 {
+	return NewPerspectiveMatrix4(float32(fovy.Degrees()), aspectRatio, near, far)
+}
+
 func NewTranslationMatrix4(x, y, z float32) *Matrix4 {
 	return &Matrix4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, x, y, z, 1}
 }
@@ -54,6 +61,7 @@ func NewLookAtMatrix4(eye, center, up Vector3) *Matrix4 {
 	}
 }
 
+// Returns a matrix rotating counter-clockwise around the axis by the angle in degrees.
 func NewRotationMatrix4(axis Vector3, angle float32) *Matrix4 {
 	axis = axis.Nor()
 	angle = DegreeToRadians * angle
@@ -68,6 +76,11 @@ func NewRotationMatrix4(axis Vector3, angle float32) *Matrix4 {
 		0, 0, 0, 1}
 }
 
+// Returns a matrix rotating counter-clockwise around the axis by the given angle.
+func NewRotationMatrix4Angle(axis Vector3, angle Angle) *Matrix4 {
+	return NewRotationMatrix4(axis, float32(angle.Degrees()))
+}
+
 func NewOrthoMatrix4(left, right, bottom, top, near, far float32) *Matrix4 {
 	xOrtho := 2 / (right - left)
 	yOrtho := 2 / (top - bottom)
@@ -79,6 +92,101 @@ func NewOrthoMatrix4(left, right, bottom, top, near, far float32) *Matrix4 {
 	return &Matrix4{M11: xOrtho, M22: yOrtho, M33: zOrtho, M41: tx, M42: ty, M43: tz, M44: 1}
 }
 
+// Returns a billboard matrix placed at position which rotates the z-axis towards the camera position.
+// The camera up vector keeps the billboard upright (spherical billboard).
+func NewBillboardMatrix4(position, cameraPosition, cameraUp Vector3) *Matrix4 {
+	zAxis := cameraPosition.Sub(position).Nor()
+	xAxis := cameraUp.Cross(zAxis).Nor()
+	yAxis := zAxis.Cross(xAxis)
+
+	return &Matrix4{
+		xAxis.X, xAxis.Y, xAxis.Z, 0,
+		yAxis.X, yAxis.Y, yAxis.Z, 0,
+		zAxis.X, zAxis.Y, zAxis.Z, 0,
+		position.X, position.Y, position.Z, 1,
+	}
+}
+
+// Returns a billboard matrix placed at position which only rotates around the given axis
+// to face the camera position as close as possible (cylindrical billboard, e.g. for trees).
+func NewCylindricalBillboardMatrix4(position, cameraPosition, axis Vector3) *Matrix4 {
+	yAxis := axis.Nor()
+	look := cameraPosition.Sub(position)
+	zAxis := look.Sub(yAxis.Scale(look.Dot(yAxis))).Nor()
+	xAxis := yAxis.Cross(zAxis)
+
+	return &Matrix4{
+		xAxis.X, xAxis.Y, xAxis.Z, 0,
+		yAxis.X, yAxis.Y, yAxis.Z, 0,
+		zAxis.X, zAxis.Y, zAxis.Z, 0,
+		position.X, position.Y, position.Z, 1,
+	}
+}
+
+// Returns a matrix which projects geometry onto the plane as seen from the light.
+// The light W component is 0 for a directional light and 1 for a point light.
+func NewShadowMatrix4(plane *Plane, light Vector4) *Matrix4 {
+	p := Vec4(plane.Normal.X, plane.Normal.Y, plane.Normal.Z, plane.D)
+	d := p.X*light.X + p.Y*light.Y + p.Z*light.Z + p.W*light.W
+
+	return &Matrix4{
+		d - light.X*p.X, -light.Y * p.X, -light.Z * p.X, -light.W * p.X,
+		-light.X * p.Y, d - light.Y*p.Y, -light.Z * p.Y, -light.W * p.Y,
+		-light.X * p.Z, -light.Y * p.Z, d - light.Z*p.Z, -light.W * p.Z,
+		-light.X * p.W, -light.Y * p.W, -light.Z * p.W, d - light.W*p.W,
+	}
+}
+
+// Returns a matrix which mirrors geometry on the plane.
+func NewReflectionMatrix4(plane *Plane) *Matrix4 {
+	n := plane.Normal
+	d := plane.D
+
+	return &Matrix4{
+		1 - 2*n.X*n.X, -2 * n.Y * n.X, -2 * n.Z * n.X, 0,
+		-2 * n.X * n.Y, 1 - 2*n.Y*n.Y, -2 * n.Z * n.Y, 0,
+		-2 * n.X * n.Z, -2 * n.Y * n.Z, 1 - 2*n.Z*n.Z, 0,
+		-2 * d * n.X, -2 * d * n.Y, -2 * d * n.Z, 1,
+	}
+}
+
+type CubeMapFace int
+
+// The cube map faces in the order of the OpenGL cube map targets.
+const (
+	CubeMapFace_PositiveX CubeMapFace = iota
+	CubeMapFace_NegativeX
+	CubeMapFace_PositiveY
+	CubeMapFace_NegativeY
+	CubeMapFace_PositiveZ
+	CubeMapFace_NegativeZ
+)
+
+var cubeMapFaceDirections = [6][2]Vector3{
+	{Vec3(1, 0, 0), Vec3(0, -1, 0)},
+	{Vec3(-1, 0, 0), Vec3(0, -1, 0)},
+	{Vec3(0, 1, 0), Vec3(0, 0, 1)},
+	{Vec3(0, -1, 0), Vec3(0, 0, -1)},
+	{Vec3(0, 0, 1), Vec3(0, -1, 0)},
+	{Vec3(0, 0, -1), Vec3(0, -1, 0)},
+}
+
+// Returns the view matrix to render the given cube map face from position.
+// Use it with a perspective matrix with a 90 degree field of view and an aspect ratio of 1.
+func NewCubeMapViewMatrix4(face CubeMapFace, position Vector3) *Matrix4 {
+	dir := cubeMapFaceDirections[face]
+	return NewLookAtMatrix4(position, position.Add(dir[0]), dir[1])
+}
+
+// Returns the view matrices of all six cube map faces rendered from position.
+func NewCubeMapViewMatrices4(position Vector3) [6]*Matrix4 {
+	var views [6]*Matrix4
+	for face := range views {
+		views[face] = NewCubeMapViewMatrix4(CubeMapFace(face), position)
+	}
+	return views
+}
+
 func (m1 *Matrix4) Set(m2 *Matrix4) *Matrix4 {
 	(*m1) = (*m2)
 	return m1
@@ -133,6 +241,8 @@ func (m *Matrix4) Scale(scalar Vector3) *Matrix4 {
 	return m.Mul(s)
 }
 
+// Inverts this matrix in place and returns it.
+// Use Inverse for a non-mutating inverse which also detects nearly singular matrices.
 func (m *Matrix4) Invert() (*Matrix4, error) {
 	det := m.Determinant()
 	if det == 0 {
@@ -179,6 +289,107 @@ func (m *Matrix4) Invert() (*Matrix4, error) {
 	return m, nil
 }
 
+// Returns the upper left 3x3 part of this matrix.
+func (m *Matrix4) Matrix3() *Matrix3 {
+	return &Matrix3{
+		m.M11, m.M12, m.M13,
+		m.M21, m.M22, m.M23,
+		m.M31, m.M32, m.M33,
+	}
+}
+
+// Returns the normal matrix of this matrix, the inverse transpose of the upper left 3x3 part.
+// Use it to transform normals with a model view matrix which contains a non-uniform scale.
+func (m *Matrix4) NormalMatrix() (*Matrix3, error) {
+	inv, err := m.Matrix3().Inverse()
+	if err != nil {
+		return nil, err
+	}
+	return inv.Transpose(), nil
+}
+
+// Returns the inverse of this matrix without modifying it.
+// The inverse is computed by a LU decomposition with partial pivoting
+// and ErrSingularMatrix is returned if the matrix is singular or too badly conditioned to be inverted at its precision.
+func (m *Matrix4) Inverse() (*Matrix4, error) {
+	inv, _, err := m.InverseCond()
+	return inv, err
+}
+
+// Returns the inverse of this matrix together with the estimated condition number in the infinity norm.
+// A large condition number means that the inverse is inaccurate, about log10 of it significant digits are lost,
+// which is most of them above 1e6 for float32 and above 1e14 for float64 matrices.
+// ErrSingularMatrix is returned if the condition number exceeds 1/epsilon.
+func (m *Matrix4) InverseCond() (*Matrix4, float32, error) {
+	a := m.rows()
+	lu, perm, _, err := luDecompose(a, 4, singularTolerance)
+	if err != nil {
+		return nil, float32(math.Inf(1)), err
+	}
+
+	inv := make([]float64, 16)
+	e := make([]float64, 4)
+	for j := 0; j < 4; j++ {
+		for i := range e {
+			e[i] = 0
+		}
+		e[j] = 1
+		x := luSolve(lu, perm, 4, e)
+		for i := 0; i < 4; i++ {
+			inv[i*4+j] = x[i]
+		}
+	}
+
+	cond := normInf(a, 4) * normInf(inv, 4)
+	if cond*singularTolerance >= 1 {
+		return nil, float32(cond), ErrSingularMatrix
+	}
+	return matrix4FromRows(inv), float32(cond), nil
+}
+
+// Returns the inverse of this affine matrix (any combination of rotation, scale, shear and translation).
+// Only the upper 3x3 part is inverted which is faster and more accurate than a general inverse.
+// ErrNotAffine is returned if the last row of the matrix is not (0, 0, 0, 1).
+func (m *Matrix4) InverseAffine() (*Matrix4, error) {
+	if m.M14 != 0 || m.M24 != 0 || m.M34 != 0 || m.M44 != 1 {
+		return nil, ErrNotAffine
+	}
+	r := m.Matrix3()
+	det := r.Determinant()
+	scale := Max(Max(Abs(r.M11), Abs(r.M12)), Max(Abs(r.M13), Abs(r.M21)))
+	scale = Max(Max(scale, Abs(r.M22)), Max(Max(Abs(r.M23), Abs(r.M31)), Max(Abs(r.M32), Abs(r.M33))))
+	if Abs(det) <= scale*scale*scale*singularTolerance {
+		return nil, ErrSingularMatrix
+	}
+	inv, err := r.Inverse()
+	if err != nil {
+		return nil, ErrSingularMatrix
+	}
+	return &Matrix4{
+		inv.M11, inv.M12, inv.M13, 0,
+		inv.M21, inv.M22, inv.M23, 0,
+		inv.M31, inv.M32, inv.M33, 0,
+		-(m.M41*inv.M11 + m.M42*inv.M21 + m.M43*inv.M31),
+		-(m.M41*inv.M12 + m.M42*inv.M22 + m.M43*inv.M32),
+		-(m.M41*inv.M13 + m.M42*inv.M23 + m.M43*inv.M33),
+		1,
+	}, nil
+}
+
+// Returns the inverse of this rigid matrix which only consists of a rotation and a translation.
+// The rotation is transposed and the translation is rotated back, no checks are performed that the matrix is rigid.
+func (m *Matrix4) InverseRigid() *Matrix4 {
+	return &Matrix4{
+		m.M11, m.M21, m.M31, 0,
+		m.M12, m.M22, m.M32, 0,
+		m.M13, m.M23, m.M33, 0,
+		-(m.M41*m.M11 + m.M42*m.M12 + m.M43*m.M13),
+		-(m.M41*m.M21 + m.M42*m.M22 + m.M43*m.M23),
+		-(m.M41*m.M31 + m.M42*m.M32 + m.M43*m.M33),
+		1,
+	}
+}
+
 // The determinant of this matrix.
 func (m *Matrix4) Determinant() float32 {
 	return m.M14*m.M23*m.M32*m.M41 -
@@ -310,3 +310,94 @@ func (test *Matrix4TestSuite) TestUnProject(c *C) {
 		c.Check(unProj, Vector3Check, value.Expected)
 	}
 }
+
+func (test *Matrix4TestSuite) TestMatrixInverse(c *C) {
+	for i := range test.invertTestTable {
+		value := test.invertTestTable[i]
+		original := *value.Matrix
+		matrix, err := value.Matrix.Inverse()
+		c.Check(err, IsNil)
+		c.Check(matrix, Matrix4Check, value.Expected)
+		c.Check(value.Matrix, Matrix4Check, &original)
+	}
+
+	_, cond, err := NewIdentityMatrix4().InverseCond()
+	c.Check(err, IsNil)
+	c.Check(cond, EqualsFloat32, float32(1))
+
+	singular := &Matrix4{1, 2, 3, 4, 2, 4, 6, 8, 0, 0, 1, 0, 0, 0, 0, 1}
+	_, err = singular.Inverse()
+	c.Check(err, Equals, ErrSingularMatrix)
+
+	nearlySingular := &Matrix4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1e-9, 0, 0, 0, 0, 1}
+	_, err = nearlySingular.Inverse()
+	c.Check(err, Equals, ErrSingularMatrix)
+}
+
+func (test *Matrix4TestSuite) TestMatrixInverseAffine(c *C) {
+	m := &Matrix4{2, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0.5, 0, 2, -4, 1, 1}
+	expected := &Matrix4{0.5, 0, 0, 0, 0, 0.25, 0, 0, 0, 0, 2, 0, -1, 1, -2, 1}
+	inv, err := m.InverseAffine()
+	c.Check(err, IsNil)
+	c.Check(inv, Matrix4Check, expected)
+
+	_, err = NewPerspectiveMatrix4(45, 1, 0.1, 100).InverseAffine()
+	c.Check(err, Equals, ErrNotAffine)
+
+	_, err = (&Matrix4{M11: 1, M22: 1, M44: 1}).InverseAffine()
+	c.Check(err, Equals, ErrSingularMatrix)
+}
+
+func (test *Matrix4TestSuite) TestMatrixInverseRigid(c *C) {
+	// 90 degree rotation around the z-axis followed by a translation.
+	m := &Matrix4{0, 1, 0, 0, -1, 0, 0, 0, 0, 0, 1, 0, 1, 2, 3, 1}
+	expected := &Matrix4{0, -1, 0, 0, 1, 0, 0, 0, 0, 0, 1, 0, -2, 1, -3, 1}
+	c.Check(m.InverseRigid(), Matrix4Check, expected)
+
+	inv, err := m.Inverse()
+	c.Check(err, IsNil)
+	c.Check(inv, Matrix4Check, expected)
+}
+
+func (test *Matrix4TestSuite) TestNormalMatrix(c *C) {
+	m := &Matrix4{2, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0.5, 0, 2, -4, 1, 1}
+	normal, err := m.NormalMatrix()
+	c.Check(err, IsNil)
+	c.Check(normal, Matrix3Check, &Matrix3{0.5, 0, 0, 0, 0.25, 0, 0, 0, 2})
+}
+
+func (test *Matrix4TestSuite) TestBillboardMatrix(c *C) {
+	m := NewBillboardMatrix4(Vec3(1, 2, 3), Vec3(6, 2, 3), Vec3(0, 1, 0))
+	c.Check(m, Matrix4Check, &Matrix4{0, 0, -1, 0, 0, 1, 0, 0, 1, 0, 0, 0, 1, 2, 3, 1})
+
+	m = NewCylindricalBillboardMatrix4(Vec3(0, 0, 0), Vec3(0, 10, 5), Vec3(0, 1, 0))
+	c.Check(m, Matrix4Check, NewIdentityMatrix4())
+}
+
+func (test *Matrix4TestSuite) TestShadowMatrix(c *C) {
+	ground := NewPlane(Vec3(0, 1, 0), 0)
+
+	m := NewShadowMatrix4(ground, Vec4(0, 1, 0, 0))
+	c.Check(m.MulVec4(Vec4(1, 5, 3, 1)), Vector4Check, Vec4(1, 0, 3, 1))
+
+	m = NewShadowMatrix4(ground, Vec4(0, 10, 0, 1))
+	shadow := m.MulVec4(Vec4(1, 5, 2, 1))
+	c.Check(shadow.Scale(1/shadow.W), Vector4Check, Vec4(2, 0, 4, 1))
+}
+
+func (test *Matrix4TestSuite) TestReflectionMatrix(c *C) {
+	m := NewReflectionMatrix4(NewPlane(Vec3(0, 1, 0), -2))
+	c.Check(m.MulVec3(Vec3(1, 5, 3)), Vector3Check, Vec3(1, -1, 3))
+	c.Check(m.Mul(m), Matrix4Check, NewIdentityMatrix4())
+}
+
+func (test *Matrix4TestSuite) TestCubeMapViewMatrix(c *C) {
+	position := Vec3(1, 2, 3)
+	views := NewCubeMapViewMatrices4(position)
+	for face, view := range views {
+		dir := cubeMapFaceDirections[face][0]
+		c.Check(view, Matrix4Check, NewCubeMapViewMatrix4(CubeMapFace(face), position))
+		// Every face looks down the negative z-axis in view space.
+		c.Check(view.MulVec3(position.Add(dir)), Vector3Check, Vec3(0, 0, -1))
+	}
+}
@@ -0,0 +1,240 @@
+package math
+
+// A dense matrix with an arbitrary number of rows and columns.
+// Unlike Matrix3 and Matrix4 the elements are stored row by row.
+type MatrixN struct {
+	Rows, Cols int
+	// Element (row r, column c) is stored at Data[r*Cols+c]
+	Data []float32
+}
+
+// Returns a zero matrix with the given number of rows and columns.
+func NewMatrixN(rows, cols int) *MatrixN {
+	return &MatrixN{Rows: rows, Cols: cols, Data: make([]float32, rows*cols)}
+}
+
+// Returns a matrix from the given rows which all have to be of the same length.
+func NewMatrixNFromRows(rows ...[]float32) (*MatrixN, error) {
+	if len(rows) == 0 {
+		return NewMatrixN(0, 0), nil
+	}
+	m := NewMatrixN(len(rows), len(rows[0]))
+	for r, row := range rows {
+		if len(row) != m.Cols {
+			return nil, ErrDimensionMismatch
+		}
+		copy(m.Data[r*m.Cols:], row)
+	}
+	return m, nil
+}
+
+func NewIdentityMatrixN(n int) *MatrixN {
+	m := NewMatrixN(n, n)
+	for i := 0; i < n; i++ {
+		m.Data[i*n+i] = 1
+	}
+	return m
+}
+
+// Returns a 3x3 matrix with the elements of the given matrix.
+func NewMatrixNFromMatrix3(m *Matrix3) *MatrixN {
+	return &MatrixN{Rows: 3, Cols: 3, Data: []float32{
+		m.M11, m.M21, m.M31,
+		m.M12, m.M22, m.M32,
+		m.M13, m.M23, m.M33,
+	}}
+}
+
+// Returns a 4x4 matrix with the elements of the given matrix.
+func NewMatrixNFromMatrix4(m *Matrix4) *MatrixN {
+	return &MatrixN{Rows: 4, Cols: 4, Data: []float32{
+		m.M11, m.M21, m.M31, m.M41,
+		m.M12, m.M22, m.M32, m.M42,
+		m.M13, m.M23, m.M33, m.M43,
+		m.M14, m.M24, m.M34, m.M44,
+	}}
+}
+
+func (m *MatrixN) Cpy() *MatrixN {
+	data := make([]float32, len(m.Data))
+	copy(data, m.Data)
+	return &MatrixN{Rows: m.Rows, Cols: m.Cols, Data: data}
+}
+
+// Returns the element at the given row and column.
+func (m *MatrixN) At(row, col int) float32 {
+	return m.Data[row*m.Cols+col]
+}
+
+// Sets the element at the given row and column.
+func (m *MatrixN) SetAt(row, col int, value float32) *MatrixN {
+	m.Data[row*m.Cols+col] = value
+	return m
+}
+
+// Returns a copy of the given row.
+func (m *MatrixN) Row(row int) VectorN {
+	return VecN(m.Data[row*m.Cols : (row+1)*m.Cols]...)
+}
+
+// Returns a copy of the given column.
+func (m *MatrixN) Col(col int) VectorN {
+	vec := make(VectorN, m.Rows)
+	for r := range vec {
+		vec[r] = m.Data[r*m.Cols+col]
+	}
+	return vec
+}
+
+// Multiplies this matrix with the provided matrix and returns a new matrix.
+func (m *MatrixN) Mul(mat *MatrixN) (*MatrixN, error) {
+	if m.Cols != mat.Rows {
+		return nil, ErrDimensionMismatch
+	}
+	out := NewMatrixN(m.Rows, mat.Cols)
+	for r := 0; r < m.Rows; r++ {
+		for c := 0; c < mat.Cols; c++ {
+			var sum float32
+			for k := 0; k < m.Cols; k++ {
+				sum += m.Data[r*m.Cols+k] * mat.Data[k*mat.Cols+c]
+			}
+			out.Data[r*out.Cols+c] = sum
+		}
+	}
+	return out, nil
+}
+
+// Multiplies this matrix with the column vector.
+func (m *MatrixN) MulVec(vec VectorN) (VectorN, error) {
+	if m.Cols != len(vec) {
+		return nil, ErrDimensionMismatch
+	}
+	out := make(VectorN, m.Rows)
+	for r := range out {
+		var sum float32
+		for c := 0; c < m.Cols; c++ {
+			sum += m.Data[r*m.Cols+c] * vec[c]
+		}
+		out[r] = sum
+	}
+	return out, nil
+}
+
+// Returns a new matrix with all elements multiplied by the scalar.
+func (m *MatrixN) Scale(scalar float32) *MatrixN {
+	out := m.Cpy()
+	for i := range out.Data {
+		out.Data[i] *= scalar
+	}
+	return out
+}
+
+// Returns this matrix transposed.
+func (m *MatrixN) Transpose() *MatrixN {
+	out := NewMatrixN(m.Cols, m.Rows)
+	for r := 0; r < m.Rows; r++ {
+		for c := 0; c < m.Cols; c++ {
+			out.Data[c*out.Cols+r] = m.Data[r*m.Cols+c]
+		}
+	}
+	return out
+}
+
+// Returns the determinant of this square matrix.
+// For (nearly) singular matrices it returns 0 together with ErrSingularMatrix.
+func (m *MatrixN) Determinant() (float32, error) {
+	if m.Rows != m.Cols {
+		return 0, ErrDimensionMismatch
+	}
+	lu, _, sign, err := luDecompose(m.float64s(), m.Rows, singularTolerance)
+	if err != nil {
+		return 0, err
+	}
+	det := sign
+	for i := 0; i < m.Rows; i++ {
+		det *= lu[i*m.Rows+i]
+	}
+	return float32(det), nil
+}
+
+// Returns the inverse of this square matrix using the LU decomposition with partial pivoting.
+func (m *MatrixN) Inverse() (*MatrixN, error) {
+	if m.Rows != m.Cols {
+		return nil, ErrDimensionMismatch
+	}
+	n := m.Rows
+	lu, perm, _, err := luDecompose(m.float64s(), n, singularTolerance)
+	if err != nil {
+		return nil, err
+	}
+	out := NewMatrixN(n, n)
+	e := make([]float64, n)
+	for c := 0; c < n; c++ {
+		for i := range e {
+			e[i] = 0
+		}
+		e[c] = 1
+		x := luSolve(lu, perm, n, e)
+		for r := 0; r < n; r++ {
+			out.Data[r*n+c] = float32(x[r])
+		}
+	}
+	return out, nil
+}
+
+// Solves M*x = b for x.
+// Square matrices are solved with the LU decomposition, matrices with more rows than columns
+// (overdetermined systems, e.g. from a regression) are solved in the least squares sense with the QR decomposition.
+func (m *MatrixN) Solve(b VectorN) (VectorN, error) {
+	if m.Rows != len(b) || m.Rows < m.Cols {
+		return nil, ErrDimensionMismatch
+	}
+	if m.Rows == m.Cols {
+		lu, perm, _, err := luDecompose(m.float64s(), m.Rows, singularTolerance)
+		if err != nil {
+			return nil, err
+		}
+		return vectorNFromFloat64s(luSolve(lu, perm, m.Rows, b.float64s())), nil
+	}
+	q, r := qrDecompose(m.float64s(), m.Rows, m.Cols)
+	x, err := qrSolve(q, r, m.Rows, m.Cols, b.float64s(), singularTolerance)
+	if err != nil {
+		return nil, err
+	}
+	return vectorNFromFloat64s(x), nil
+}
+
+// Returns the elements as a Matrix3, the matrix has to be 3x3.
+func (m *MatrixN) Matrix3() (*Matrix3, error) {
+	if m.Rows != 3 || m.Cols != 3 {
+		return nil, ErrDimensionMismatch
+	}
+	d := m.Data
+	return &Matrix3{
+		d[0], d[3], d[6],
+		d[1], d[4], d[7],
+		d[2], d[5], d[8],
+	}, nil
+}
+
+// Returns the elements as a Matrix4, the matrix has to be 4x4.
+func (m *MatrixN) Matrix4() (*Matrix4, error) {
+	if m.Rows != 4 || m.Cols != 4 {
+		return nil, ErrDimensionMismatch
+	}
+	d := m.Data
+	return &Matrix4{
+		d[0], d[4], d[8], d[12],
+		d[1], d[5], d[9], d[13],
+		d[2], d[6], d[10], d[14],
+		d[3], d[7], d[11], d[15],
+	}, nil
+}
+
+func (m *MatrixN) float64s() []float64 {
+	out := make([]float64, len(m.Data))
+	for i := range m.Data {
+		out[i] = float64(m.Data[i])
+	}
+	return out
+}
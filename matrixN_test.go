@@ -0,0 +1,164 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type MatrixNTestSuite struct{}
+
+var _ = Suite(&MatrixNTestSuite{})
+
+func (s *MatrixNTestSuite) TestNewMatrixNFromRows(c *C) {
+	m, err := NewMatrixNFromRows([]float32{1, 2, 3}, []float32{4, 5, 6})
+	c.Check(err, IsNil)
+	c.Check(m.Rows, Equals, 2)
+	c.Check(m.Cols, Equals, 3)
+	c.Check(m.At(1, 0), Equals, float32(4))
+	c.Check(m.Row(1), DeepEquals, VecN(4, 5, 6))
+	c.Check(m.Col(2), DeepEquals, VecN(3, 6))
+
+	_, err = NewMatrixNFromRows([]float32{1, 2, 3}, []float32{4, 5})
+	c.Check(err, Equals, ErrDimensionMismatch)
+}
+
+func (s *MatrixNTestSuite) TestMul(c *C) {
+	a, _ := NewMatrixNFromRows([]float32{1, 2, 3}, []float32{4, 5, 6})
+	b, _ := NewMatrixNFromRows([]float32{7, 8}, []float32{9, 10}, []float32{11, 12})
+	expected, _ := NewMatrixNFromRows([]float32{58, 64}, []float32{139, 154})
+	m, err := a.Mul(b)
+	c.Check(err, IsNil)
+	c.Check(m, DeepEquals, expected)
+
+	_, err = a.Mul(a)
+	c.Check(err, Equals, ErrDimensionMismatch)
+
+	v, err := a.MulVec(VecN(1, 0, -1))
+	c.Check(err, IsNil)
+	c.Check(v, DeepEquals, VecN(-2, -2))
+	_, err = a.MulVec(VecN(1, 0))
+	c.Check(err, Equals, ErrDimensionMismatch)
+}
+
+func (s *MatrixNTestSuite) TestTranspose(c *C) {
+	a, _ := NewMatrixNFromRows([]float32{1, 2, 3}, []float32{4, 5, 6})
+	expected, _ := NewMatrixNFromRows([]float32{1, 4}, []float32{2, 5}, []float32{3, 6})
+	c.Check(a.Transpose(), DeepEquals, expected)
+}
+
+func (s *MatrixNTestSuite) TestInverse(c *C) {
+	m, _ := NewMatrixNFromRows(
+		[]float32{2, 0, 0, 0, 0},
+		[]float32{0, 4, 0, 0, 0},
+		[]float32{0, 0, 1, 0, 0},
+		[]float32{0, 0, 0, 0.5, 0},
+		[]float32{0, 0, 0, 0, 8})
+	expected, _ := NewMatrixNFromRows(
+		[]float32{0.5, 0, 0, 0, 0},
+		[]float32{0, 0.25, 0, 0, 0},
+		[]float32{0, 0, 1, 0, 0},
+		[]float32{0, 0, 0, 2, 0},
+		[]float32{0, 0, 0, 0, 0.125})
+	inv, err := m.Inverse()
+	c.Check(err, IsNil)
+	c.Check(inv, DeepEquals, expected)
+
+	det, err := m.Determinant()
+	c.Check(err, IsNil)
+	c.Check(det, EqualsFloat32, float32(32))
+
+	_, err = NewMatrixN(2, 2).Inverse()
+	c.Check(err, Equals, ErrSingularMatrix)
+	det, err = NewMatrixN(2, 2).Determinant()
+	c.Check(err, Equals, ErrSingularMatrix)
+	c.Check(det, Equals, float32(0))
+	_, err = NewMatrixN(2, 3).Inverse()
+	c.Check(err, Equals, ErrDimensionMismatch)
+}
+
+func (s *MatrixNTestSuite) TestSolve(c *C) {
+	m := NewMatrixNFromMatrix3(NewMatrix3(2, 1, 1, 1, 3, 4, 1, 2, 5))
+	x, err := m.Solve(VecN(7, 13, 24))
+	c.Check(err, IsNil)
+	v, err := x.Vec3()
+	c.Check(err, IsNil)
+	c.Check(v, Vector3Check, Vec3(1, 2, 3))
+
+	// Least squares fit of the line y = 2x + 1 through noisy samples.
+	a, _ := NewMatrixNFromRows(
+		[]float32{0, 1},
+		[]float32{1, 1},
+		[]float32{2, 1},
+		[]float32{3, 1})
+	x, err = a.Solve(VecN(1.1, 2.9, 5.1, 6.9))
+	c.Check(err, IsNil)
+	c.Check(x[0], EqualsFloat32, float32(1.96))
+	c.Check(x[1], EqualsFloat32, float32(1.06))
+
+	_, err = a.Transpose().Solve(VecN(1, 2))
+	c.Check(err, Equals, ErrDimensionMismatch)
+}
+
+func (s *MatrixNTestSuite) TestConversion(c *C) {
+	m3 := NewMatrix3(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	c.Check(NewMatrixNFromMatrix3(m3).At(0, 1), Equals, float32(4))
+	out3, err := NewMatrixNFromMatrix3(m3).Matrix3()
+	c.Check(err, IsNil)
+	c.Check(out3, DeepEquals, m3)
+
+	m4 := NewTranslationMatrix4(1, 2, 3)
+	c.Check(NewMatrixNFromMatrix4(m4).At(2, 3), Equals, float32(3))
+	out4, err := NewMatrixNFromMatrix4(m4).Matrix4()
+	c.Check(err, IsNil)
+	c.Check(out4, DeepEquals, m4)
+
+	_, err = NewMatrixNFromMatrix4(m4).Matrix3()
+	c.Check(err, Equals, ErrDimensionMismatch)
+}
+
+func (s *MatrixNTestSuite) TestVectorN(c *C) {
+	v := VecN(1, 2, 2)
+	c.Check(v.Dim(), Equals, 3)
+	c.Check(v.Len(), Equals, float32(3))
+	sum, err := v.Add(VecN(1, 1, 1))
+	c.Check(err, IsNil)
+	c.Check(sum, DeepEquals, VecN(2, 3, 3))
+	difference, err := v.Sub(VecN(1, 1, 1))
+	c.Check(err, IsNil)
+	c.Check(difference, DeepEquals, VecN(0, 1, 1))
+	product, err := v.Mul(VecN(2, 2, 2))
+	c.Check(err, IsNil)
+	c.Check(product, DeepEquals, VecN(2, 4, 4))
+	c.Check(v.Scale(2), DeepEquals, VecN(2, 4, 4))
+	dot, err := v.Dot(VecN(1, 1, 1))
+	c.Check(err, IsNil)
+	c.Check(dot, Equals, float32(5))
+	distance, err := v.Distance(VecN(1, 2, 0))
+	c.Check(err, IsNil)
+	c.Check(distance, Equals, float32(2))
+	c.Check(v.Nor().Len(), EqualsFloat32, float32(1))
+	c.Check(v.Equals(Vec3(1, 2, 2).VecN()), Equals, true)
+	v3, err := v.Vec3()
+	c.Check(err, IsNil)
+	c.Check(v3, Equals, Vec3(1, 2, 2))
+	v2, err := VecN(1, 2).Vec2()
+	c.Check(err, IsNil)
+	c.Check(v2, Equals, Vec2(1, 2))
+	v4, err := VecN(1, 2, 3, 4).Vec4()
+	c.Check(err, IsNil)
+	c.Check(v4, Equals, Vec4(1, 2, 3, 4))
+
+	_, err = v.Add(VecN(1))
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = v.Sub(VecN(1))
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = v.Mul(VecN(1))
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = v.Dot(VecN(1))
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = v.Distance(VecN(1))
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = v.Vec2()
+	c.Check(err, Equals, ErrDimensionMismatch)
+	_, err = v.Vec4()
+	c.Check(err, Equals, ErrDimensionMismatch)
+}
@@ -0,0 +1,124 @@
+package math
+
+import (
+	"errors"
+)
+
+var (
+	// Returned by Push when the stack has reached its maximum depth.
+	ErrMatrixStackOverflow = errors.New("matrix stack overflow")
+	// Returned by Pop when only the bottom matrix is left on the stack.
+	ErrMatrixStackUnderflow = errors.New("matrix stack underflow")
+)
+
+// The depth of the OpenGL modelview matrix stack required by the specification.
+const DefaultMatrixStackDepth = 32
+
+// A stack of transformation matrices which works like the fixed-function OpenGL matrix stack
+// (glPushMatrix, glPopMatrix, glTranslate, ...).
+// All transformations are multiplied onto the top matrix from the right.
+type MatrixStack struct {
+	stack    []Matrix4
+	maxDepth int
+
+	inverse      *Matrix4
+	inverseValid bool
+}
+
+// Returns a new stack containing the identity matrix which can be pushed maxDepth-1 times.
+func NewMatrixStack(maxDepth int) *MatrixStack {
+	if maxDepth < 1 {
+		maxDepth = 1
+	}
+	s := &MatrixStack{stack: make([]Matrix4, 1, maxDepth), maxDepth: maxDepth}
+	s.stack[0] = *NewIdentityMatrix4()
+	return s
+}
+
+// The number of matrices on the stack, it is at least one.
+func (s *MatrixStack) Depth() int {
+	return len(s.stack)
+}
+
+// Returns a copy of the top matrix.
+func (s *MatrixStack) Top() *Matrix4 {
+	top := s.stack[len(s.stack)-1]
+	return &top
+}
+
+// Returns the inverse of the top matrix.
+// The inverse is cached until the top matrix changes.
+func (s *MatrixStack) TopInverse() (*Matrix4, error) {
+	if !s.inverseValid {
+		inv, err := s.top().Inverse()
+		if err != nil {
+			return nil, err
+		}
+		s.inverse = inv
+		s.inverseValid = true
+	}
+	inv := *s.inverse
+	return &inv, nil
+}
+
+// Duplicates the top matrix.
+func (s *MatrixStack) Push() error {
+	if len(s.stack) >= s.maxDepth {
+		return ErrMatrixStackOverflow
+	}
+	s.stack = append(s.stack, s.stack[len(s.stack)-1])
+	return nil
+}
+
+// Removes the top matrix.
+func (s *MatrixStack) Pop() error {
+	if len(s.stack) <= 1 {
+		return ErrMatrixStackUnderflow
+	}
+	s.stack = s.stack[:len(s.stack)-1]
+	s.inverseValid = false
+	return nil
+}
+
+// Replaces the top matrix with the identity matrix.
+func (s *MatrixStack) LoadIdentity() *MatrixStack {
+	return s.Load(NewIdentityMatrix4())
+}
+
+// Replaces the top matrix with the given matrix.
+func (s *MatrixStack) Load(m *Matrix4) *MatrixStack {
+	s.top().Set(m)
+	s.inverseValid = false
+	return s
+}
+
+// Multiplies the top matrix with the given matrix.
+func (s *MatrixStack) Mul(m *Matrix4) *MatrixStack {
+	top := s.top()
+	top.Set(top.Mul(m))
+	s.inverseValid = false
+	return s
+}
+
+// Multiplies the top matrix with a translation matrix.
+func (s *MatrixStack) Translate(x, y, z float32) *MatrixStack {
+	return s.Mul(NewTranslationMatrix4(x, y, z))
+}
+
+// Multiplies the top matrix with a rotation matrix around the axis.
+// Angle in degrees
+func (s *MatrixStack) Rotate(axis Vector3, angle float32) *MatrixStack {
+	return s.Mul(NewRotationMatrix4(axis, angle))
+}
+
+// Multiplies the top matrix with a scale matrix.
+func (s *MatrixStack) Scale(x, y, z float32) *MatrixStack {
+	top := s.top()
+	top.Set(top.Scale(Vec3(x, y, z)))
+	s.inverseValid = false
+	return s
+}
+
+func (s *MatrixStack) top() *Matrix4 {
+	return &s.stack[len(s.stack)-1]
+}
@@ -0,0 +1,62 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type MatrixStackTestSuite struct{}
+
+var _ = Suite(&MatrixStackTestSuite{})
+
+func (s *MatrixStackTestSuite) TestPushPop(c *C) {
+	stack := NewMatrixStack(2)
+	c.Check(stack.Depth(), Equals, 1)
+	c.Check(stack.Top(), Matrix4Check, NewIdentityMatrix4())
+
+	c.Check(stack.Push(), IsNil)
+	stack.Translate(1, 2, 3)
+	c.Check(stack.Depth(), Equals, 2)
+	c.Check(stack.Top(), Matrix4Check, NewTranslationMatrix4(1, 2, 3))
+	c.Check(stack.Push(), Equals, ErrMatrixStackOverflow)
+
+	c.Check(stack.Pop(), IsNil)
+	c.Check(stack.Top(), Matrix4Check, NewIdentityMatrix4())
+	c.Check(stack.Pop(), Equals, ErrMatrixStackUnderflow)
+}
+
+func (s *MatrixStackTestSuite) TestTransform(c *C) {
+	stack := NewMatrixStack(DefaultMatrixStackDepth)
+	stack.Translate(1, 2, 3).Rotate(Vec3(0, 1, 0), 25).Scale(2, 2, 2)
+	expected := NewTranslationMatrix4(1, 2, 3).Mul(NewRotationMatrix4(Vec3(0, 1, 0), 25)).Scale(Vec3(2, 2, 2))
+	c.Check(stack.Top(), Matrix4Check, expected)
+
+	stack.LoadIdentity().Mul(expected)
+	c.Check(stack.Top(), Matrix4Check, expected)
+
+	stack.Load(NewTranslationMatrix4(-3, 2.2, 15))
+	c.Check(stack.Top(), Matrix4Check, NewTranslationMatrix4(-3, 2.2, 15))
+}
+
+func (s *MatrixStackTestSuite) TestTopInverse(c *C) {
+	stack := NewMatrixStack(DefaultMatrixStackDepth)
+	stack.Translate(-3, 2.2, 15)
+	inv, err := stack.TopInverse()
+	c.Check(err, IsNil)
+	c.Check(inv, Matrix4Check, NewTranslationMatrix4(3, -2.2, -15))
+
+	// The cached inverse has to follow the top matrix.
+	c.Check(stack.Push(), IsNil)
+	stack.Translate(3, -2.2, -15)
+	inv, err = stack.TopInverse()
+	c.Check(err, IsNil)
+	c.Check(inv, Matrix4Check, NewIdentityMatrix4())
+
+	c.Check(stack.Pop(), IsNil)
+	inv, err = stack.TopInverse()
+	c.Check(err, IsNil)
+	c.Check(inv, Matrix4Check, NewTranslationMatrix4(3, -2.2, -15))
+
+	stack.Scale(0, 1, 1)
+	_, err = stack.TopInverse()
+	c.Check(err, Equals, ErrSingularMatrix)
+}
@@ -0,0 +1,67 @@
+package math
+
+// A loose octree over items with BoundingBox bounds.
+// Each node is enlarged by the looseness factor, so an item is stored in the single deepest node
+// whose enlarged bounds contain it and never has to be split across nodes.
+// With the default looseness of 2 an item is stored at a depth where the nodes are at least as large as the item.
+// Items are identified by the id returned from Insert, see itemPool.
+type Octree struct {
+	tree *looseTree
+}
+
+// Returns an empty octree for the world bounds. The nodes are cubes, items outside of the
+// bounds are stored in the root node. The nodes are subdivided up to maxDepth times.
+func NewOctree(bounds BoundingBox, maxDepth int, looseness float32) *Octree {
+	return &Octree{newLooseTree(&bounds, 3, maxDepth, looseness)}
+}
+
+// The number of items
+func (o *Octree) Len() int {
+	return o.tree.pool.count
+}
+
+// Inserts an item with the bounds and returns its id. data can be used to associate an object with the item.
+func (o *Octree) Insert(bounds BoundingBox, data interface{}) int {
+	return o.tree.insert(&bounds, data)
+}
+
+// Removes the item, see itemPool.
+func (o *Octree) Remove(item int) {
+	o.tree.remove(item)
+}
+
+// Updates the bounds of the item after it moved or changed its size.
+func (o *Octree) Update(item int, bounds BoundingBox) {
+	o.tree.update(item, &bounds)
+}
+
+// The bounds of the item.
+func (o *Octree) Bounds(item int) BoundingBox {
+	return *o.tree.bounds(item)
+}
+
+// The data given when the item was inserted.
+func (o *Octree) Data(item int) interface{} {
+	return o.tree.pool.get(item)
+}
+
+// Appends the items whose bounds overlap the box to result.
+func (o *Octree) QueryBounds(box *BoundingBox, result []int) []int {
+	return o.tree.query(o.tree.root, func(bounds *BoundingBox) bool { return bounds.Overlaps(box) }, result)
+}
+
+// Appends the items whose bounds overlap the sphere to result.
+func (o *Octree) QuerySphere(sphere *Sphere, result []int) []int {
+	return o.tree.query(o.tree.root, func(bounds *BoundingBox) bool { return IntersectSphereBounds(sphere, bounds) }, result)
+}
+
+// Appends the items whose bounds are at least partially in the frustum to result.
+// Like Frustum.BoundsInFrustum the test is conservative, items close to the frustum may be reported as well.
+func (o *Octree) QueryFrustum(frustum *Frustum, result []int) []int {
+	return o.tree.query(o.tree.root, frustum.BoundsInFrustum, result)
+}
+
+// Appends the k items nearest to the point to result, ordered by the distance of their bounds to the point.
+func (o *Octree) Nearest(point Vector3, k int, result []int) []int {
+	return o.tree.nearest(point, k, result)
+}
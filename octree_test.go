@@ -0,0 +1,172 @@
+package math
+
+import (
+	"math/rand"
+	"sort"
+
+	. "launchpad.net/gocheck"
+)
+
+type OctreeTestSuite struct {
+	rnd    *rand.Rand
+	octree *Octree
+	items  []int
+	bounds map[int]BoundingBox
+}
+
+var _ = Suite(&OctreeTestSuite{})
+
+func (s *OctreeTestSuite) SetUpTest(c *C) {
+	s.rnd = rand.New(rand.NewSource(1))
+	s.octree = NewOctree(BoundingBox{Min: Vec3(0, 0, 0), Max: Vec3(100, 100, 100)}, DefaultLooseTreeMaxDepth, DefaultLooseness)
+	s.items = nil
+	s.bounds = make(map[int]BoundingBox)
+	for i, box := range randomBounds(s.rnd, 500, 5) {
+		item := s.octree.Insert(box, i)
+		s.items = append(s.items, item)
+		s.bounds[item] = box
+	}
+}
+
+// Checks that all items are stored in nodes whose loose bounds contain them and that the counts are correct.
+func checkLooseTree(c *C, t *looseTree) {
+	var check func(node *looseTreeNode) int
+	check = func(node *looseTreeNode) int {
+		count := len(node.items)
+		loose := t.looseBounds(node.center, node.halfSize)
+		for i, item := range node.items {
+			c.Assert(t.pool.live[item], Equals, true)
+			c.Assert(t.items[item].node, Equals, node)
+			c.Assert(t.items[item].index, Equals, i)
+			if node != t.root {
+				c.Assert(loose.Contains(&t.items[item].bounds), Equals, true)
+			}
+		}
+		for i, child := range node.children {
+			if child != nil {
+				c.Assert(child.parent, Equals, node)
+				c.Assert(child.index, Equals, i)
+				count += check(child)
+			}
+		}
+		c.Assert(count > 0 || node == t.root, Equals, true)
+		c.Assert(node.count, Equals, count)
+		return count
+	}
+	c.Assert(check(t.root), Equals, t.pool.count)
+}
+
+func (s *OctreeTestSuite) expected(overlaps func(bounds *BoundingBox) bool) []int {
+	var expected []int
+	for item, box := range s.bounds {
+		if overlaps(&box) {
+			expected = append(expected, item)
+		}
+	}
+	return sortedInts(expected)
+}
+
+func (s *OctreeTestSuite) TestInsert(c *C) {
+	checkLooseTree(c, s.octree.tree)
+	c.Check(s.octree.Len(), Equals, 500)
+	c.Check(s.octree.Data(s.items[5]), Equals, 5)
+	c.Check(s.octree.Bounds(s.items[5]), Equals, s.bounds[s.items[5]])
+
+	// Small items are stored deep in the tree, large ones and those outside of the world bounds at the root.
+	small := s.octree.Insert(BoundingBox{Min: Vec3(10, 10, 10), Max: Vec3(10.1, 10.1, 10.1)}, nil)
+	c.Check(s.octree.tree.items[small].node.depth, Equals, DefaultLooseTreeMaxDepth)
+	large := s.octree.Insert(BoundingBox{Min: Vec3(10, 10, 10), Max: Vec3(90, 90, 90)}, nil)
+	c.Check(s.octree.tree.items[large].node, Equals, s.octree.tree.root)
+	outside := s.octree.Insert(BoundingBox{Min: Vec3(200, 10, 10), Max: Vec3(201, 11, 11)}, nil)
+	c.Check(s.octree.tree.items[outside].node, Equals, s.octree.tree.root)
+	checkLooseTree(c, s.octree.tree)
+
+	result := s.octree.QueryBounds(&BoundingBox{Min: Vec3(199, 9, 9), Max: Vec3(200.5, 10.5, 10.5)}, nil)
+	c.Check(result, DeepEquals, []int{outside})
+}
+
+func (s *OctreeTestSuite) TestQuery(c *C) {
+	box := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	expected := s.expected(func(bounds *BoundingBox) bool { return bounds.Overlaps(box) })
+	c.Assert(len(expected) > 0, Equals, true)
+	c.Check(sortedInts(s.octree.QueryBounds(box, nil)), DeepEquals, expected)
+
+	sphere := NewSphere(Vec3(50, 50, 50), 15)
+	expected = s.expected(func(bounds *BoundingBox) bool { return IntersectSphereBounds(sphere, bounds) })
+	c.Assert(len(expected) > 0, Equals, true)
+	c.Check(sortedInts(s.octree.QuerySphere(sphere, nil)), DeepEquals, expected)
+
+	projection := NewPerspectiveMatrix4(60, 1, 1, 40)
+	view := NewLookAtMatrix4(Vec3(50, 50, -10), Vec3(50, 50, 50), Vec3(0, 1, 0))
+	inv, err := projection.Mul(view).Inverse()
+	c.Assert(err, IsNil)
+	frustum := NewFrustum()
+	frustum.Update(inv)
+	expected = s.expected(frustum.BoundsInFrustum)
+	c.Assert(len(expected) > 0, Equals, true)
+	c.Check(sortedInts(s.octree.QueryFrustum(frustum, nil)), DeepEquals, expected)
+}
+
+func (s *OctreeTestSuite) TestUpdateRemove(c *C) {
+	for i := 0; i < 1000; i++ {
+		item := s.items[s.rnd.Intn(len(s.items))]
+		d := Vec3(s.rnd.Float32()-0.5, s.rnd.Float32()-0.5, s.rnd.Float32()-0.5).Scale(10)
+		box := s.bounds[item]
+		box = BoundingBox{Min: box.Min.Add(d), Max: box.Max.Add(d)}
+		s.octree.Update(item, box)
+		s.bounds[item] = box
+	}
+	checkLooseTree(c, s.octree.tree)
+	box := &BoundingBox{Min: Vec3(20, 30, 40), Max: Vec3(45, 50, 60)}
+	c.Check(sortedInts(s.octree.QueryBounds(box, nil)), DeepEquals, s.expected(func(bounds *BoundingBox) bool { return bounds.Overlaps(box) }))
+
+	for i := 0; i < len(s.items); i += 2 {
+		s.octree.Remove(s.items[i])
+		delete(s.bounds, s.items[i])
+	}
+	checkLooseTree(c, s.octree.tree)
+	c.Check(s.octree.Len(), Equals, 250)
+	c.Check(sortedInts(s.octree.QueryBounds(box, nil)), DeepEquals, s.expected(func(bounds *BoundingBox) bool { return bounds.Overlaps(box) }))
+
+	// The ids of removed items are reused.
+	item := s.octree.Insert(BoundingBox{}, nil)
+	c.Check(item < len(s.items), Equals, true)
+	s.octree.Remove(item)
+	c.Check(func() { s.octree.Remove(item) }, PanicMatches, "invalid item id")
+	c.Check(func() { s.octree.Update(item, BoundingBox{}) }, PanicMatches, "invalid item id")
+	c.Check(func() { s.octree.Bounds(len(s.items)) }, PanicMatches, "invalid item id")
+	c.Check(s.octree.Len(), Equals, 250)
+
+	for item := range s.bounds {
+		s.octree.Remove(item)
+	}
+	c.Check(s.octree.tree.root.children, HasLen, 8)
+	for _, child := range s.octree.tree.root.children {
+		c.Check(child, IsNil)
+	}
+}
+
+func (s *OctreeTestSuite) TestNearest(c *C) {
+	for i := 0; i < 20; i++ {
+		point := Vec3(s.rnd.Float32()*100, s.rnd.Float32()*100, s.rnd.Float32()*100)
+		nearest := s.octree.Nearest(point, 10, nil)
+		c.Assert(nearest, HasLen, 10)
+		// Compare the distances as items can have the same distance.
+		var distances []float32
+		for _, box := range s.bounds {
+			distances = append(distances, box.ClosestPoint(point).Distance2(point))
+		}
+		sort.Sort(float32Slice(distances))
+		for j, item := range nearest {
+			box := s.bounds[item]
+			c.Check(box.ClosestPoint(point).Distance2(point), Equals, distances[j])
+		}
+	}
+	c.Check(s.octree.Nearest(Vec3(0, 0, 0), 1000, nil), HasLen, 500)
+}
+
+type float32Slice []float32
+
+func (s float32Slice) Len() int           { return len(s) }
+func (s float32Slice) Less(i, j int) bool { return s[i] < s[j] }
+func (s float32Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,272 @@
+package math
+
+// A box which, unlike BoundingBox, can be rotated.
+type OrientedBoundingBox struct {
+	Center Vector3
+	// Half the size of the box along each of its axes.
+	HalfExtents Vector3
+	// The orthonormal axes of the box as the columns of the rotation matrix, see Axis.
+	Rotation Matrix3
+}
+
+func NewOrientedBoundingBox(center, halfExtents Vector3, rotation *Matrix3) *OrientedBoundingBox {
+	return &OrientedBoundingBox{Center: center, HalfExtents: halfExtents, Rotation: *rotation}
+}
+
+func NewOrientedBoundingBoxFromQuaternion(center, halfExtents Vector3, rotation *Quaternion) *OrientedBoundingBox {
+	return NewOrientedBoundingBox(center, halfExtents, rotation.Matrix().Matrix3())
+}
+
+// Returns the box around the points whose axes are the principal components of the points.
+// The box fits well for elongated point sets but isn't necessarily the smallest one,
+// see NewMinVolumeOrientedBoundingBox.
+func NewOrientedBoundingBoxFromPoints(points []Vector3) *OrientedBoundingBox {
+	obb := &OrientedBoundingBox{}
+	obb.fit(points, principalAxes(points))
+	return obb
+}
+
+// Returns a box around the points with a small volume. It starts with the box of
+// NewOrientedBoundingBoxFromPoints and the axis aligned box and improves them by
+// rotating them around their axes as long as the volume shrinks.
+// This is slower than NewOrientedBoundingBoxFromPoints and the result is in general not the exact minimum.
+func NewMinVolumeOrientedBoundingBox(points []Vector3) *OrientedBoundingBox {
+	best := &OrientedBoundingBox{}
+	best.fit(points, principalAxes(points))
+	candidate := &OrientedBoundingBox{}
+	candidate.fit(points, [3]Vector3{Vec3(1, 0, 0), Vec3(0, 1, 0), Vec3(0, 0, 1)})
+	if candidate.Volume() < best.Volume() {
+		best, candidate = candidate, best
+	}
+
+	rotation := NewQuaternion(0, 0, 0, 1)
+	for _, step := range []float32{10, 2, 0.5, 0.1} {
+		step *= DegreeToRadians
+		for iteration, improved := 0, true; improved && iteration < 100; iteration++ {
+			improved = false
+			for i := 0; i < 3; i++ {
+				axis := best.Axis(i)
+				for _, angle := range []float32{step, -step} {
+					rotation.SetFromAxis(axis.X, axis.Y, axis.Z, angle)
+					candidate.fit(points, [3]Vector3{rotation.Transform(best.Axis(0)), rotation.Transform(best.Axis(1)), rotation.Transform(best.Axis(2))})
+					if candidate.Volume() < best.Volume() {
+						best, candidate = candidate, best
+						improved = true
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+// Returns the principal axes of the points as a right-handed orthonormal basis.
+func principalAxes(points []Vector3) [3]Vector3 {
+	if len(points) == 0 {
+		return [3]Vector3{Vec3(1, 0, 0), Vec3(0, 1, 0), Vec3(0, 0, 1)}
+	}
+	var mean Vector3
+	for _, p := range points {
+		mean = mean.Add(p)
+	}
+	mean = mean.Scale(1 / float32(len(points)))
+	var covariance Matrix3
+	for _, p := range points {
+		d := p.Sub(mean)
+		covariance.M11 += d.X * d.X
+		covariance.M22 += d.Y * d.Y
+		covariance.M33 += d.Z * d.Z
+		covariance.M12 += d.X * d.Y
+		covariance.M13 += d.X * d.Z
+		covariance.M23 += d.Y * d.Z
+	}
+	covariance.M21, covariance.M31, covariance.M32 = covariance.M12, covariance.M13, covariance.M23
+	_, axes := covariance.SymmetricEigen()
+	axes[2] = axes[0].Cross(axes[1]).Nor()
+	return axes
+}
+
+// Sets the box to the smallest box around the points with the orthonormal axes.
+func (obb *OrientedBoundingBox) fit(points []Vector3, axes [3]Vector3) {
+	obb.Rotation = Matrix3{axes[0].X, axes[0].Y, axes[0].Z, axes[1].X, axes[1].Y, axes[1].Z, axes[2].X, axes[2].Y, axes[2].Z}
+	if len(points) == 0 {
+		obb.Center, obb.HalfExtents = Vector3{}, Vector3{}
+		return
+	}
+	min := obb.toLocal(points[0])
+	max := min
+	for _, p := range points[1:] {
+		local := obb.toLocal(p)
+		min = min.Min(local)
+		max = max.Max(local)
+	}
+	obb.HalfExtents = max.Sub(min).Scale(0.5)
+	obb.Center = obb.fromLocal(min.Add(max).Scale(0.5))
+}
+
+// Returns the coordinates of the point along the axes of the rotation, relative to the origin.
+func (obb *OrientedBoundingBox) toLocal(point Vector3) Vector3 {
+	return Vec3(point.Dot(obb.Axis(0)), point.Dot(obb.Axis(1)), point.Dot(obb.Axis(2)))
+}
+
+func (obb *OrientedBoundingBox) fromLocal(local Vector3) Vector3 {
+	return obb.Axis(0).Scale(local.X).Add(obb.Axis(1).Scale(local.Y)).Add(obb.Axis(2).Scale(local.Z))
+}
+
+func (obb *OrientedBoundingBox) Cpy() *OrientedBoundingBox {
+	return &OrientedBoundingBox{obb.Center, obb.HalfExtents, obb.Rotation}
+}
+
+// Returns the x (0), y (1) or z (2) axis of the box, the column of the rotation.
+func (obb *OrientedBoundingBox) Axis(i int) Vector3 {
+	m := &obb.Rotation
+	switch i {
+	case 0:
+		return Vec3(m.M11, m.M12, m.M13)
+	case 1:
+		return Vec3(m.M21, m.M22, m.M23)
+	case 2:
+		return Vec3(m.M31, m.M32, m.M33)
+	}
+	panic(ErrIndexOutOfRange)
+}
+
+// Returns the rotation of the box as quaternion.
+func (obb *OrientedBoundingBox) Quaternion() *Quaternion {
+	m := &obb.Rotation
+	return NewQuaternion(0, 0, 0, 1).SetFromAxes(m.M11, m.M12, m.M13, m.M21, m.M22, m.M23, m.M31, m.M32, m.M33)
+}
+
+func (obb *OrientedBoundingBox) Volume() float32 {
+	return 8 * obb.HalfExtents.X * obb.HalfExtents.Y * obb.HalfExtents.Z
+}
+
+// Returns the 8 corners of the box.
+func (obb *OrientedBoundingBox) Corners() []Vector3 {
+	x := obb.Axis(0).Scale(obb.HalfExtents.X)
+	y := obb.Axis(1).Scale(obb.HalfExtents.Y)
+	z := obb.Axis(2).Scale(obb.HalfExtents.Z)
+	corners := make([]Vector3, 8)
+	for i := range corners {
+		corner := obb.Center
+		for axis, v := range [3]Vector3{x, y, z} {
+			if i&(1<<uint(axis)) == 0 {
+				corner = corner.Sub(v)
+			} else {
+				corner = corner.Add(v)
+			}
+		}
+		corners[i] = corner
+	}
+	return corners
+}
+
+// Returns the smallest axis aligned box around this box.
+func (obb *OrientedBoundingBox) Bounds() BoundingBox {
+	m := &obb.Rotation
+	h := obb.HalfExtents
+	extent := Vec3(
+		Abs(m.M11)*h.X+Abs(m.M21)*h.Y+Abs(m.M31)*h.Z,
+		Abs(m.M12)*h.X+Abs(m.M22)*h.Y+Abs(m.M32)*h.Z,
+		Abs(m.M13)*h.X+Abs(m.M23)*h.Y+Abs(m.M33)*h.Z)
+	return BoundingBox{Min: obb.Center.Sub(extent), Max: obb.Center.Add(extent)}
+}
+
+// Returns whether the point is inside of the box or on its surface.
+func (obb *OrientedBoundingBox) ContainsVec(point Vector3) bool {
+	local := obb.toLocal(point.Sub(obb.Center)).Abs()
+	return local.X <= obb.HalfExtents.X && local.Y <= obb.HalfExtents.Y && local.Z <= obb.HalfExtents.Z
+}
+
+// Returns the point of the box nearest to the point, the point itself if it is inside.
+func (obb *OrientedBoundingBox) ClosestPoint(point Vector3) Vector3 {
+	local := obb.toLocal(point.Sub(obb.Center))
+	local = local.Max(obb.HalfExtents.Scale(-1)).Min(obb.HalfExtents)
+	return obb.Center.Add(obb.fromLocal(local))
+}
+
+// Returns whether the boxes overlap, using the separating axis theorem.
+func (obb *OrientedBoundingBox) Overlaps(other *OrientedBoundingBox) bool {
+	a := [3]Vector3{obb.Axis(0), obb.Axis(1), obb.Axis(2)}
+	b := [3]Vector3{other.Axis(0), other.Axis(1), other.Axis(2)}
+	ea, eb := obb.HalfExtents.ToArray(), other.HalfExtents.ToArray()
+
+	// The rotation of the other box in the coordinates of this box.
+	// The epsilon avoids false separations by the cross products of nearly parallel axes.
+	const epsilon = 1e-6
+	var r, absR [3][3]float32
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = a[i].Dot(b[j])
+			absR[i][j] = Abs(r[i][j]) + epsilon
+		}
+	}
+	t := obb.toLocal(other.Center.Sub(obb.Center)).ToArray()
+
+	// The axes of this box.
+	for i := 0; i < 3; i++ {
+		rb := eb[0]*absR[i][0] + eb[1]*absR[i][1] + eb[2]*absR[i][2]
+		if Abs(t[i]) > ea[i]+rb {
+			return false
+		}
+	}
+	// The axes of the other box.
+	for j := 0; j < 3; j++ {
+		ra := ea[0]*absR[0][j] + ea[1]*absR[1][j] + ea[2]*absR[2][j]
+		if Abs(t[0]*r[0][j]+t[1]*r[1][j]+t[2]*r[2][j]) > ra+eb[j] {
+			return false
+		}
+	}
+	// The cross products of the axes of both boxes.
+	for i := 0; i < 3; i++ {
+		i1, i2 := (i+1)%3, (i+2)%3
+		for j := 0; j < 3; j++ {
+			j1, j2 := (j+1)%3, (j+2)%3
+			ra := ea[i1]*absR[i2][j] + ea[i2]*absR[i1][j]
+			rb := eb[j1]*absR[i][j2] + eb[j2]*absR[i][j1]
+			if Abs(t[i2]*r[i1][j]-t[i1]*r[i2][j]) > ra+rb {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns whether the ray hits the box and the distance of the hit in multiples of the ray direction.
+// The distance is 0 if the ray starts inside of the box.
+func (obb *OrientedBoundingBox) IntersectRay(ray *Ray) (float32, bool) {
+	origin := obb.toLocal(ray.Origin.Sub(obb.Center))
+	direction := obb.toLocal(ray.Direction)
+	box := BoundingBox{Min: obb.HalfExtents.Scale(-1), Max: obb.HalfExtents}
+	return intersectRayBounds(origin, Vec3(1/direction.X, 1/direction.Y, 1/direction.Z), &box, MaxFloat32)
+}
+
+// Returns whether the box is at least partially in the frustum.
+// Like Frustum.BoundsInFrustum the test is conservative, boxes close to the frustum may be reported as well.
+func (obb *OrientedBoundingBox) InFrustum(frustum *Frustum) bool {
+	for _, plane := range []*Plane{frustum.Left, frustum.Right, frustum.Top, frustum.Bottom, frustum.Near, frustum.Far} {
+		// The extent of the box along the plane normal.
+		local := obb.toLocal(plane.Normal).Abs()
+		radius := local.Dot(obb.HalfExtents)
+		if plane.Distance(obb.Center) < -radius {
+			return false
+		}
+	}
+	return true
+}
+
+// Transforms the box by the matrix. The result is exact for rotations, translations and uniform scaling,
+// for other transformations it is a box around the transformed box.
+func (obb *OrientedBoundingBox) Transform(m *Matrix4) *OrientedBoundingBox {
+	corners := obb.Corners()
+	for i := range corners {
+		corners[i] = m.MulVec3(corners[i])
+	}
+	// Orthonormalize the transformed axes.
+	origin := m.MulVec3(Vector3{})
+	x := m.MulVec3(obb.Axis(0)).Sub(origin).Nor()
+	y := m.MulVec3(obb.Axis(1)).Sub(origin)
+	y = y.Sub(x.Scale(x.Dot(y))).Nor()
+	obb.fit(corners, [3]Vector3{x, y, x.Cross(y)})
+	return obb
+}
@@ -0,0 +1,201 @@
+package math
+
+import (
+	"math/rand"
+
+	. "launchpad.net/gocheck"
+)
+
+type OrientedBoundingBoxTestSuite struct{}
+
+var _ = Suite(&OrientedBoundingBoxTestSuite{})
+
+func randomOBB(rnd *rand.Rand) *OrientedBoundingBox {
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(rnd.Float32()-0.5, rnd.Float32()-0.5, rnd.Float32()-0.5, rnd.Float32()*2*Pi)
+	center := Vec3(rnd.Float32(), rnd.Float32(), rnd.Float32()).Scale(6)
+	halfExtents := Vec3(rnd.Float32(), rnd.Float32(), rnd.Float32()).Scale(2).Add(Vec3(0.1, 0.1, 0.1))
+	return NewOrientedBoundingBoxFromQuaternion(center, halfExtents, rotation)
+}
+
+// Returns points on a grid inside of the box.
+func obbSamples(obb *OrientedBoundingBox) []Vector3 {
+	var points []Vector3
+	for x := float32(-1); x <= 1; x += 0.25 {
+		for y := float32(-1); y <= 1; y += 0.25 {
+			for z := float32(-1); z <= 1; z += 0.25 {
+				points = append(points, obb.Center.Add(obb.fromLocal(obb.HalfExtents.Mul(Vec3(x, y, z)))))
+			}
+		}
+	}
+	return points
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestRotation(c *C) {
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7)
+	obb := NewOrientedBoundingBoxFromQuaternion(Vec3(1, 2, 3), Vec3(1, 2, 3), rotation)
+	c.Check(obb.Axis(0), Vector3Check, rotation.Transform(Vec3(1, 0, 0)))
+	c.Check(obb.Axis(1), Vector3Check, rotation.Transform(Vec3(0, 1, 0)))
+	c.Check(obb.Axis(2), Vector3Check, rotation.Transform(Vec3(0, 0, 1)))
+	c.Check(sameRotation(obb.Quaternion(), rotation), Equals, true)
+	c.Check(obb.Volume(), EqualsFloat32, float32(48))
+
+	corners := obb.Corners()
+	bounds := obb.Bounds()
+	for _, corner := range corners {
+		c.Check(obb.ContainsVec(corner.Lerp(obb.Center, 0.01)), Equals, true)
+		c.Check(obb.ContainsVec(obb.Center.Add(corner.Sub(obb.Center).Scale(1.01))), Equals, false)
+		c.Check(corner.X >= bounds.Min.X-0.0001 && corner.X <= bounds.Max.X+0.0001, Equals, true)
+		c.Check(corner.Y >= bounds.Min.Y-0.0001 && corner.Y <= bounds.Max.Y+0.0001, Equals, true)
+		c.Check(corner.Z >= bounds.Min.Z-0.0001 && corner.Z <= bounds.Max.Z+0.0001, Equals, true)
+	}
+	// The axis aligned box is tight, the corners reach its faces.
+	fitted := BoundingBox{Min: corners[0], Max: corners[0]}
+	for _, corner := range corners {
+		fitted.Min = fitted.Min.Min(corner)
+		fitted.Max = fitted.Max.Max(corner)
+	}
+	c.Check(bounds.Min, Vector3Check, fitted.Min)
+	c.Check(bounds.Max, Vector3Check, fitted.Max)
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestClosestPoint(c *C) {
+	obb := NewOrientedBoundingBoxFromQuaternion(Vec3(0, 0, 0), Vec3(2, 1, 1), NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2))
+	c.Check(obb.ClosestPoint(Vec3(0.5, 0.5, 0.5)), Vector3Check, Vec3(0.5, 0.5, 0.5))
+	c.Check(obb.ClosestPoint(Vec3(5, 5, 0)), Vector3Check, Vec3(1, 2, 0))
+	c.Check(obb.ClosestPoint(Vec3(0, -5, 3)).Distance(Vec3(0, -2, 1)) < 0.00001, Equals, true)
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestOverlaps(c *C) {
+	identity := NewIdentityMatrix3()
+	a := NewOrientedBoundingBox(Vec3(0, 0, 0), Vec3(1, 1, 1), identity)
+	// Two boxes rotated by 45 degrees whose edges are separated only along the cross product of the edges.
+	b := NewOrientedBoundingBoxFromQuaternion(Vec3(2.3, 2.3, 0), Vec3(1, 1, 1),
+		NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/4).Mul(NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 0, Pi/4)))
+	c.Check(a.Bounds().Max.X >= b.Bounds().Min.X, Equals, true)
+	c.Check(a.Overlaps(b), Equals, false)
+	b.Center = Vec3(1.5, 1.5, 0)
+	c.Check(a.Overlaps(b), Equals, true)
+	c.Check(b.Overlaps(a), Equals, true)
+
+	// Boxes with a common point inside overlap and the test is symmetric.
+	rnd := rand.New(rand.NewSource(1))
+	overlapping := 0
+	for i := 0; i < 300; i++ {
+		a, b := randomOBB(rnd), randomOBB(rnd)
+		overlaps := a.Overlaps(b)
+		c.Assert(b.Overlaps(a), Equals, overlaps)
+		if overlaps {
+			overlapping++
+		}
+		for _, p := range obbSamples(a) {
+			if b.ContainsVec(p) {
+				c.Assert(overlaps, Equals, true)
+				break
+			}
+		}
+	}
+	c.Check(overlapping > 30 && overlapping < 270, Equals, true)
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestIntersectRay(c *C) {
+	obb := NewOrientedBoundingBoxFromQuaternion(Vec3(5, 0, 0), Vec3(1, 2, 3), NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2))
+	distance, ok := obb.IntersectRay(NewRay(Vec3(0, 0, 0), Vec3(1, 0, 0)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, EqualsFloat32, float32(3))
+	distance, ok = obb.IntersectRay(NewRay(Vec3(0, 0, 0), Vec3(2, 0, 0)))
+	c.Check(distance, EqualsFloat32, float32(1.5))
+	_, ok = obb.IntersectRay(NewRay(Vec3(0, 1.5, 0), Vec3(1, 0, 0)))
+	c.Check(ok, Equals, false)
+	_, ok = obb.IntersectRay(NewRay(Vec3(0, 0, 0), Vec3(-1, 0, 0)))
+	c.Check(ok, Equals, false)
+	distance, ok = obb.IntersectRay(NewRay(Vec3(5, 0, 0), Vec3(0, 0, 1)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, Equals, float32(0))
+
+	// The hit point lies on the surface of the box.
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 100; i++ {
+		obb := randomOBB(rnd)
+		ray := NewRay(Vec3(-10, rnd.Float32()*6, rnd.Float32()*6), obb.Center.Sub(Vec3(-10, 3, 3)))
+		if distance, ok := obb.IntersectRay(ray); ok {
+			local := obb.toLocal(ray.GetEndPoint(distance).Sub(obb.Center)).Abs().Sub(obb.HalfExtents)
+			c.Check(Abs(local.MaxComponent()) < 0.001, Equals, true)
+		}
+	}
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestInFrustum(c *C) {
+	projection := NewPerspectiveMatrix4(60, 1, 1, 40)
+	view := NewLookAtMatrix4(Vec3(0, 0, 0), Vec3(0, 0, -1), Vec3(0, 1, 0))
+	inv, err := projection.Mul(view).Inverse()
+	c.Assert(err, IsNil)
+	frustum := NewFrustum()
+	frustum.Update(inv)
+
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, Pi/4)
+	c.Check(NewOrientedBoundingBoxFromQuaternion(Vec3(0, 0, -10), Vec3(1, 1, 1), rotation).InFrustum(frustum), Equals, true)
+	c.Check(NewOrientedBoundingBoxFromQuaternion(Vec3(0, 0, 10), Vec3(1, 1, 1), rotation).InFrustum(frustum), Equals, false)
+	c.Check(NewOrientedBoundingBoxFromQuaternion(Vec3(0, 0, -50), Vec3(1, 1, 1), rotation).InFrustum(frustum), Equals, false)
+	// A long thin box reaching into the frustum from the side.
+	c.Check(NewOrientedBoundingBoxFromQuaternion(Vec3(20, 0, -10), Vec3(15, 0.1, 0.1), NewQuaternion(0, 0, 0, 1)).InFrustum(frustum), Equals, true)
+	c.Check(NewOrientedBoundingBoxFromQuaternion(Vec3(20, 0, -10), Vec3(15, 0.1, 0.1), rotation).InFrustum(frustum), Equals, false)
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestTransform(c *C) {
+	rnd := rand.New(rand.NewSource(3))
+	obb := randomOBB(rnd)
+	rotation := NewQuaternion(0, 0, 0, 1).SetFromAxis(3, -1, 2, 1.1)
+	m := NewTranslationMatrix4(1, 2, 3).Mul(rotation.Matrix()).Scale(Vec3(2, 2, 2))
+	corners := obb.Corners()
+	transformed := obb.Cpy().Transform(m)
+	c.Check(transformed.Volume(), EqualsFloat32, obb.Volume()*8)
+	c.Check(transformed.Center, Vector3Check, m.MulVec3(obb.Center))
+	for i, corner := range transformed.Corners() {
+		c.Check(corner, Vector3Check, m.MulVec3(corners[i]))
+	}
+
+	// Non-uniform scaling gives a box around the transformed box.
+	m = NewIdentityMatrix4().Scale(Vec3(1, 3, 1))
+	transformed = obb.Cpy().Transform(m)
+	for _, corner := range corners {
+		c.Check(transformed.ContainsVec(transformed.Center.Lerp(m.MulVec3(corner), 0.999)), Equals, true)
+	}
+}
+
+func (s *OrientedBoundingBoxTestSuite) TestFromPoints(c *C) {
+	rnd := rand.New(rand.NewSource(4))
+	obb := randomOBB(rnd)
+	points := obbSamples(obb)
+	pca := NewOrientedBoundingBoxFromPoints(points)
+	minVolume := NewMinVolumeOrientedBoundingBox(points)
+	for _, p := range points {
+		c.Check(pca.ContainsVec(pca.Center.Lerp(p, 0.999)), Equals, true)
+		c.Check(minVolume.ContainsVec(minVolume.Center.Lerp(p, 0.999)), Equals, true)
+	}
+	c.Check(minVolume.Volume() <= pca.Volume(), Equals, true)
+	c.Check(minVolume.Volume() < obb.Volume()*1.05, Equals, true)
+	c.Check(minVolume.Center, Vector3Check, obb.Center)
+
+	// The points of an elongated box give its axes.
+	axes := principalAxes(points)
+	c.Check(Abs(axes[0].Dot(obb.Axis(longestAxis(obb.HalfExtents)))) > 0.99, Equals, true)
+	c.Check(axes[0].Cross(axes[1]).Dot(axes[2]), EqualsFloat32, float32(1))
+
+	// The minimum volume box of points on a cube rotated in the plane is the cube itself,
+	// while the principal axes are ambiguous.
+	cube := NewOrientedBoundingBoxFromQuaternion(Vec3(1, 2, 3), Vec3(1, 1, 1), NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, 0.3))
+	minVolume = NewMinVolumeOrientedBoundingBox(cube.Corners())
+	c.Check(minVolume.Volume() < 8.1, Equals, true)
+
+	c.Check(NewOrientedBoundingBoxFromPoints(nil).Volume(), Equals, float32(0))
+}
+
+func longestAxis(v Vector3) int {
+	axis := 0
+	for i := 1; i < 3; i++ {
+		if v.Get(i) > v.Get(axis) {
+			axis = i
+		}
+	}
+	return axis
+}
@@ -0,0 +1,94 @@
+package math
+
+// Packs the components of the vector clamped to [0,1] into 8 bit unsigned normalized integers,
+// like GLSL packUnorm4x8. X is stored in the least significant bits.
+func PackUnorm4x8(vec Vector4) uint32 {
+	return packUnorm8(vec.X) | packUnorm8(vec.Y)<<8 | packUnorm8(vec.Z)<<16 | packUnorm8(vec.W)<<24
+}
+
+// Unpacks four 8 bit unsigned normalized integers, like GLSL unpackUnorm4x8.
+func UnpackUnorm4x8(packed uint32) Vector4 {
+	return Vec4(
+		float32(packed&0xff)/255,
+		float32(packed>>8&0xff)/255,
+		float32(packed>>16&0xff)/255,
+		float32(packed>>24&0xff)/255)
+}
+
+// Packs the components of the vector into 16 bit floating point numbers, like GLSL packHalf2x16.
+// X is stored in the least significant bits.
+func PackHalf2x16(vec Vector2) uint32 {
+	return uint32(float32ToHalf(vec.X)) | uint32(float32ToHalf(vec.Y))<<16
+}
+
+// Unpacks two 16 bit floating point numbers, like GLSL unpackHalf2x16.
+func UnpackHalf2x16(packed uint32) Vector2 {
+	return Vec2(halfToFloat32(uint16(packed)), halfToFloat32(uint16(packed>>16)))
+}
+
+func packUnorm8(value float32) uint32 {
+	return uint32(Floor(Clampf(value, 0, 1)*255 + 0.5))
+}
+
+// Converts to the nearest half precision float, ties are rounded to even.
+func float32ToHalf(value float32) uint16 {
+	bits := Floatbits(value)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			// NaN
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	exp = exp - 127 + 15
+	if exp >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	var shift uint32 = 13
+	if exp <= 0 {
+		// Subnormal half
+		if exp < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift = uint32(14 - exp)
+		exp = 0
+	}
+	half := uint32(exp)<<10 | mant>>shift
+	round := uint32(1) << (shift - 1)
+	rest := mant & (round<<1 - 1)
+	if rest > round || (rest == round && half&1 == 1) {
+		// A carry into the exponent is correct, it may even round up to infinity.
+		half++
+	}
+	return sign | uint16(half)
+}
+
+func halfToFloat32(half uint16) float32 {
+	sign := uint32(half&0x8000) << 16
+	exp := uint32(half>>10) & 0x1f
+	mant := uint32(half & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return Floatfrombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		if mant == 0 {
+			return Floatfrombits(sign)
+		}
+		// Normalize the subnormal half
+		exp = 127 - 15 + 1
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		return Floatfrombits(sign | exp<<23 | (mant&0x3ff)<<13)
+	}
+	return Floatfrombits(sign | (exp+127-15)<<23 | mant<<13)
+}
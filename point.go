@@ -39,3 +39,209 @@ func (p Point) Div(q Point) Point {
 func (p Point) Equals(q Point) bool {
 	return p.X == q.X && p.Y == q.Y
 }
+
+func (p Point) Scale(scalar int) Point {
+	p.X *= scalar
+	p.Y *= scalar
+	return p
+}
+
+// Returns the point with the absolute values of its coordinates.
+func (p Point) Abs() Point {
+	return Point{absi(p.X), absi(p.Y)}
+}
+
+// Returns the componentwise minimum of both points.
+func (p Point) Min(q Point) Point {
+	return Point{mini(p.X, q.X), mini(p.Y, q.Y)}
+}
+
+// Returns the componentwise maximum of both points.
+func (p Point) Max(q Point) Point {
+	return Point{maxi(p.X, q.X), maxi(p.Y, q.Y)}
+}
+
+// The distance when only moving along the axes, |dx| + |dy|.
+func (p Point) ManhattanDistance(q Point) int {
+	return absi(p.X-q.X) + absi(p.Y-q.Y)
+}
+
+// The distance when diagonal moves are allowed, max(|dx|, |dy|).
+func (p Point) ChebyshevDistance(q Point) int {
+	return maxi(absi(p.X-q.X), absi(p.Y-q.Y))
+}
+
+// Returns the point with each component clamped to the range [min, max].
+func (p Point) Clamp(min, max Point) Point {
+	p.X = Clampi(p.X, min.X, max.X)
+	p.Y = Clampi(p.Y, min.Y, max.Y)
+	return p
+}
+
+// Returns the 4 neighbours sharing an edge with this point.
+func (p Point) Neighbours4() [4]Point {
+	return [4]Point{
+		{p.X + 1, p.Y},
+		{p.X, p.Y + 1},
+		{p.X - 1, p.Y},
+		{p.X, p.Y - 1},
+	}
+}
+
+// Returns the 8 neighbours sharing an edge or a corner with this point.
+func (p Point) Neighbours8() [8]Point {
+	return [8]Point{
+		{p.X + 1, p.Y},
+		{p.X + 1, p.Y + 1},
+		{p.X, p.Y + 1},
+		{p.X - 1, p.Y + 1},
+		{p.X - 1, p.Y},
+		{p.X - 1, p.Y - 1},
+		{p.X, p.Y - 1},
+		{p.X + 1, p.Y - 1},
+	}
+}
+
+func (p Point) Vector2() Vector2 {
+	return Vec2(float32(p.X), float32(p.Y))
+}
+
+// A point with integer coordinates in 3D space, e.g. a voxel.
+type Point3 struct {
+	X, Y, Z int
+}
+
+func Pt3(x, y, z int) Point3 {
+	return Point3{X: x, Y: y, Z: z}
+}
+
+func (p Point3) Cpy() Point3 {
+	return Point3{X: p.X, Y: p.Y, Z: p.Z}
+}
+
+func (p Point3) Add(q Point3) Point3 {
+	p.X += q.X
+	p.Y += q.Y
+	p.Z += q.Z
+	return p
+}
+
+func (p Point3) Sub(q Point3) Point3 {
+	p.X -= q.X
+	p.Y -= q.Y
+	p.Z -= q.Z
+	return p
+}
+
+func (p Point3) Mul(q Point3) Point3 {
+	p.X *= q.X
+	p.Y *= q.Y
+	p.Z *= q.Z
+	return p
+}
+
+func (p Point3) Div(q Point3) Point3 {
+	p.X /= q.X
+	p.Y /= q.Y
+	p.Z /= q.Z
+	return p
+}
+
+func (p Point3) Scale(scalar int) Point3 {
+	p.X *= scalar
+	p.Y *= scalar
+	p.Z *= scalar
+	return p
+}
+
+func (p Point3) Equals(q Point3) bool {
+	return p.X == q.X && p.Y == q.Y && p.Z == q.Z
+}
+
+// Returns the point with the absolute values of its coordinates.
+func (p Point3) Abs() Point3 {
+	return Point3{absi(p.X), absi(p.Y), absi(p.Z)}
+}
+
+// Returns the componentwise minimum of both points.
+func (p Point3) Min(q Point3) Point3 {
+	return Point3{mini(p.X, q.X), mini(p.Y, q.Y), mini(p.Z, q.Z)}
+}
+
+// Returns the componentwise maximum of both points.
+func (p Point3) Max(q Point3) Point3 {
+	return Point3{maxi(p.X, q.X), maxi(p.Y, q.Y), maxi(p.Z, q.Z)}
+}
+
+// The distance when only moving along the axes, |dx| + |dy| + |dz|.
+func (p Point3) ManhattanDistance(q Point3) int {
+	return absi(p.X-q.X) + absi(p.Y-q.Y) + absi(p.Z-q.Z)
+}
+
+// The distance when diagonal moves are allowed, max(|dx|, |dy|, |dz|).
+func (p Point3) ChebyshevDistance(q Point3) int {
+	return maxi(maxi(absi(p.X-q.X), absi(p.Y-q.Y)), absi(p.Z-q.Z))
+}
+
+// Returns the point clamped componentwise to the range [min, max].
+func (p Point3) Clamp(min, max Point3) Point3 {
+	p.X = Clampi(p.X, min.X, max.X)
+	p.Y = Clampi(p.Y, min.Y, max.Y)
+	p.Z = Clampi(p.Z, min.Z, max.Z)
+	return p
+}
+
+// Returns the 6 neighbours sharing a face with this point.
+func (p Point3) Neighbours6() [6]Point3 {
+	return [6]Point3{
+		{p.X + 1, p.Y, p.Z},
+		{p.X - 1, p.Y, p.Z},
+		{p.X, p.Y + 1, p.Z},
+		{p.X, p.Y - 1, p.Z},
+		{p.X, p.Y, p.Z + 1},
+		{p.X, p.Y, p.Z - 1},
+	}
+}
+
+// Returns the 26 neighbours sharing a face, an edge or a corner with this point.
+func (p Point3) Neighbours26() [26]Point3 {
+	var neighbours [26]Point3
+	i := 0
+	for z := -1; z <= 1; z++ {
+		for y := -1; y <= 1; y++ {
+			for x := -1; x <= 1; x++ {
+				if x == 0 && y == 0 && z == 0 {
+					continue
+				}
+				neighbours[i] = Point3{p.X + x, p.Y + y, p.Z + z}
+				i++
+			}
+		}
+	}
+	return neighbours
+}
+
+func (p Point3) Vector3() Vector3 {
+	return Vec3(float32(p.X), float32(p.Y), float32(p.Z))
+}
+
+func absi(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func mini(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxi(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,100 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type PointTestSuite struct{}
+
+var _ = Suite(&PointTestSuite{})
+
+func (s *PointTestSuite) TestDistances(c *C) {
+	p := Pt(1, 2)
+	q := Pt(-2, 6)
+	c.Check(p.ManhattanDistance(q), Equals, 7)
+	c.Check(p.ChebyshevDistance(q), Equals, 4)
+
+	p3 := Pt3(1, 2, 3)
+	q3 := Pt3(-2, 6, 1)
+	c.Check(p3.ManhattanDistance(q3), Equals, 9)
+	c.Check(p3.ChebyshevDistance(q3), Equals, 4)
+}
+
+func (s *PointTestSuite) TestComponentwise(c *C) {
+	c.Check(Pt(-1, 5).Min(Pt(2, -3)), Equals, Pt(-1, -3))
+	c.Check(Pt(-1, 5).Max(Pt(2, -3)), Equals, Pt(2, 5))
+	c.Check(Pt(-1, 5).Abs(), Equals, Pt(1, 5))
+	c.Check(Pt(-1, 5).Scale(3), Equals, Pt(-3, 15))
+
+	c.Check(Pt3(-1, 5, 0).Min(Pt3(2, -3, 1)), Equals, Pt3(-1, -3, 0))
+	c.Check(Pt3(-1, 5, 0).Max(Pt3(2, -3, 1)), Equals, Pt3(2, 5, 1))
+	c.Check(Pt3(-1, 5, -7).Abs(), Equals, Pt3(1, 5, 7))
+	c.Check(Pt3(7, 5, -7).Clamp(Pt3(0, 0, 0), Pt3(4, 8, 4)), Equals, Pt3(4, 5, 0))
+}
+
+func (s *PointTestSuite) TestClamp(c *C) {
+	c.Check(Pt(12, -3).Clamp(Pt(0, 0), Pt(10, 5)), Equals, Pt(10, 0))
+	c.Check(Pt(3, 4).Clamp(Pt(0, 0), Pt(10, 5)), Equals, Pt(3, 4))
+
+	r := Recti(0, 0, 10, 5)
+	c.Check(r.ClampPoint(Pt(12, -3)), Equals, Pt(9, 0))
+	c.Check(r.ClampPoint(Pt(3, 4)), Equals, Pt(3, 4))
+}
+
+func (s *PointTestSuite) TestNeighbours(c *C) {
+	p := Pt(3, 4)
+	for _, n := range p.Neighbours4() {
+		c.Check(p.ManhattanDistance(n), Equals, 1)
+	}
+	seen := map[Point]bool{}
+	for _, n := range p.Neighbours8() {
+		c.Check(p.ChebyshevDistance(n), Equals, 1)
+		seen[n] = true
+	}
+	c.Check(len(seen), Equals, 8)
+
+	p3 := Pt3(3, 4, 5)
+	for _, n := range p3.Neighbours6() {
+		c.Check(p3.ManhattanDistance(n), Equals, 1)
+	}
+	seen3 := map[Point3]bool{}
+	for _, n := range p3.Neighbours26() {
+		c.Check(p3.ChebyshevDistance(n), Equals, 1)
+		seen3[n] = true
+	}
+	c.Check(len(seen3), Equals, 26)
+}
+
+func (s *PointTestSuite) TestConversion(c *C) {
+	v := Vec2(1.5, -1.5)
+	c.Check(v.FloorPoint(), Equals, Pt(1, -2))
+	c.Check(v.RoundPoint(), Equals, Pt(2, -1))
+	c.Check(v.CeilPoint(), Equals, Pt(2, -1))
+	c.Check(Vec2(0.4, -0.6).RoundPoint(), Equals, Pt(0, -1))
+	c.Check(Pt(3, -4).Vector2(), Equals, Vec2(3, -4))
+
+	v3 := Vec3(1.2, -0.2, 2.7)
+	c.Check(v3.FloorPoint3(), Equals, Pt3(1, -1, 2))
+	c.Check(v3.RoundPoint3(), Equals, Pt3(1, 0, 3))
+	c.Check(v3.CeilPoint3(), Equals, Pt3(2, 0, 3))
+	c.Check(Pt3(3, -4, 5).Vector3(), Equals, Vec3(3, -4, 5))
+}
+
+func (s *PointTestSuite) TestRectanglei(c *C) {
+	r := Recti(1, 2, 4, 3)
+	c.Check(r.Area(), Equals, 12)
+	c.Check(r.Contains(Pt(1, 2)), Equals, true)
+	c.Check(r.Contains(Pt(4, 4)), Equals, true)
+	c.Check(r.Contains(Pt(5, 4)), Equals, false)
+	c.Check(r.ContainsRec(Recti(2, 3, 3, 2)), Equals, true)
+	c.Check(r.ContainsRec(Recti(2, 3, 4, 2)), Equals, false)
+
+	c.Check(*r.Intersection(Recti(3, 0, 5, 3)), Equals, *Recti(3, 2, 2, 1))
+	c.Check(r.Overlaps(Recti(3, 0, 5, 3)), Equals, true)
+	// Touching rectangles don't share a cell.
+	c.Check(r.Overlaps(Recti(5, 2, 1, 1)), Equals, false)
+	c.Check(r.Intersection(Recti(10, 10, 1, 1)).Area(), Equals, 0)
+
+	c.Check(*r.Cpy().Merge(Recti(-1, 3, 1, 5)), Equals, *Recti(-1, 2, 6, 6))
+	c.Check(*r.Rectangle(), Equals, *Rect(1, 2, 4, 3))
+}
@@ -36,8 +36,7 @@ func (p *Polygon) TransformedVertices() []float32 {
 	if p.worldVertices == nil || len(p.worldVertices) < len(localVertices) {
 		p.worldVertices = make([]float32, len(localVertices))
 	}
-	cos := Cos(p.rotation) * DegreeToRadians
-	sin := Sin(p.rotation) * DegreeToRadians
+	sin, cos := Sincos(p.rotation * DegreeToRadians)
 
 	for i := 0; i < len(localVertices); i += 2 {
 		x := localVertices[i] - p.origin.X
@@ -85,6 +84,14 @@ func (p *Polygon) Rotate(degrees float32) {
 	p.dirty = true
 }
 
+func (p *Polygon) SetRotationAngle(angle Angle) {
+	p.SetRotation(float32(angle.Degrees()))
+}
+
+func (p *Polygon) RotateAngle(angle Angle) {
+	p.Rotate(float32(angle.Degrees()))
+}
+
 func (p *Polygon) SetScale(scalar Vector2) {
 	p.scalar = scalar
 	p.dirty = true
@@ -168,7 +175,8 @@ func (p *Polygon) Contains(vec Vector2) bool {
 	return (intersects & 1) == 1
 }
 
-func (p *Polygon) Position() Vector2 { return p.position }
-func (p *Polygon) Origin() Vector2   { return p.origin }
-func (p *Polygon) Rotation() float32 { return p.rotation }
-func (p *Polygon) Scalar() Vector2   { return p.scalar }
+func (p *Polygon) Position() Vector2      { return p.position }
+func (p *Polygon) Origin() Vector2        { return p.origin }
+func (p *Polygon) Rotation() float32      { return p.rotation }
+func (p *Polygon) RotationAngle() Degrees { return Degrees(p.rotation) }
+func (p *Polygon) Scalar() Vector2        { return p.scalar }
@@ -0,0 +1,23 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type PolygonTestSuite struct{}
+
+var _ = Suite(&PolygonTestSuite{})
+
+func (s *PolygonTestSuite) TestRotation(c *C) {
+	p, err := NewPolygon([]float32{1, 0, 2, 0, 2, 1})
+	c.Assert(err, IsNil)
+	p.SetScale(Vec2(1, 1))
+	p.SetRotation(90)
+	expected := []float32{0, 1, 0, 2, -1, 2}
+	c.Check(nearlyEqualValues(p.TransformedVertices(), expected), Equals, true)
+
+	p.SetRotationAngle(Radians(Pi / 4))
+	p.RotateAngle(Degrees(45))
+	c.Check(p.RotationAngle(), Equals, Degrees(90))
+	c.Check(nearlyEqualValues(p.TransformedVertices(), expected), Equals, true)
+}
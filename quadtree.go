@@ -0,0 +1,65 @@
+package math
+
+// A loose quadtree over items with Rectangle bounds, the 2D counterpart of Octree.
+// Items are identified by the id returned from Insert, see itemPool.
+type Quadtree struct {
+	tree *looseTree
+}
+
+// Returns an empty quadtree for the world bounds. The nodes are squares, items outside of the
+// bounds are stored in the root node. The nodes are subdivided up to maxDepth times.
+func NewQuadtree(bounds Rectangle, maxDepth int, looseness float32) *Quadtree {
+	box := rectangleBounds(&bounds)
+	return &Quadtree{newLooseTree(&box, 2, maxDepth, looseness)}
+}
+
+// The number of items
+func (q *Quadtree) Len() int {
+	return q.tree.pool.count
+}
+
+// Inserts an item with the bounds and returns its id. data can be used to associate an object with the item.
+func (q *Quadtree) Insert(bounds Rectangle, data interface{}) int {
+	box := rectangleBounds(&bounds)
+	return q.tree.insert(&box, data)
+}
+
+// Removes the item, see itemPool.
+func (q *Quadtree) Remove(item int) {
+	q.tree.remove(item)
+}
+
+// Updates the bounds of the item after it moved or changed its size.
+func (q *Quadtree) Update(item int, bounds Rectangle) {
+	box := rectangleBounds(&bounds)
+	q.tree.update(item, &box)
+}
+
+// The bounds of the item.
+func (q *Quadtree) Bounds(item int) Rectangle {
+	bounds := q.tree.bounds(item)
+	return Rectangle{bounds.Min.X, bounds.Min.Y, bounds.Max.X - bounds.Min.X, bounds.Max.Y - bounds.Min.Y}
+}
+
+// The data given when the item was inserted.
+func (q *Quadtree) Data(item int) interface{} {
+	return q.tree.pool.get(item)
+}
+
+// Appends the items whose bounds overlap the rectangle to result.
+// Use this instead of testing all pairs of rectangles with Rectangle.Overlaps.
+func (q *Quadtree) QueryRect(rect *Rectangle, result []int) []int {
+	box := rectangleBounds(rect)
+	return q.tree.query(q.tree.root, func(bounds *BoundingBox) bool { return bounds.Overlaps(&box) }, result)
+}
+
+// Appends the items whose bounds overlap the circle to result.
+func (q *Quadtree) QueryCircle(circle *Circle, result []int) []int {
+	sphere := NewSphere(Vec3(circle.X, circle.Y, 0), circle.Radius)
+	return q.tree.query(q.tree.root, func(bounds *BoundingBox) bool { return IntersectSphereBounds(sphere, bounds) }, result)
+}
+
+// Appends the k items nearest to the point to result, ordered by the distance of their bounds to the point.
+func (q *Quadtree) Nearest(point Vector2, k int, result []int) []int {
+	return q.tree.nearest(Vec3(point.X, point.Y, 0), k, result)
+}
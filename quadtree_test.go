@@ -0,0 +1,37 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type QuadtreeTestSuite struct{}
+
+var _ = Suite(&QuadtreeTestSuite{})
+
+func (s *QuadtreeTestSuite) TestQuadtree(c *C) {
+	quadtree := NewQuadtree(Rectangle{0, 0, 100, 50}, 4, DefaultLooseness)
+	a := quadtree.Insert(Rectangle{10, 10, 2, 2}, "a")
+	b := quadtree.Insert(Rectangle{11, 11, 2, 2}, "b")
+	d := quadtree.Insert(Rectangle{80, 40, 1, 1}, "d")
+	large := quadtree.Insert(Rectangle{0, 0, 90, 40}, "large")
+	checkLooseTree(c, quadtree.tree)
+	c.Check(quadtree.Len(), Equals, 4)
+	c.Check(quadtree.Data(b), Equals, "b")
+	c.Check(quadtree.Bounds(d), Equals, Rectangle{80, 40, 1, 1})
+	c.Check(quadtree.tree.items[a].node.depth, Equals, 4)
+	c.Check(quadtree.tree.items[large].node.depth, Equals, 0)
+
+	c.Check(sortedInts(quadtree.QueryRect(Rect(12.5, 12.5, 1, 1), nil)), DeepEquals, []int{b, large})
+	c.Check(sortedInts(quadtree.QueryRect(Rect(85, 45, 1, 1), nil)), HasLen, 0)
+	c.Check(sortedInts(quadtree.QueryCircle(&Circle{79, 39, 1.5}, nil)), DeepEquals, []int{d, large})
+	c.Check(quadtree.Nearest(Vec2(95, 45), 2, nil), DeepEquals, []int{large, d})
+
+	quadtree.Update(d, Rectangle{9, 9, 0.5, 0.5})
+	checkLooseTree(c, quadtree.tree)
+	quadtree.Remove(large)
+	c.Check(func() { quadtree.Remove(large) }, PanicMatches, "invalid item id")
+	c.Check(func() { quadtree.Data(large) }, PanicMatches, "invalid item id")
+	c.Check(quadtree.Nearest(Vec2(0, 0), 3, nil), DeepEquals, []int{d, a, b})
+	c.Check(sortedInts(quadtree.QueryRect(Rect(0, 0, 100, 50), nil)), DeepEquals, []int{a, b, d})
+	checkLooseTree(c, quadtree.tree)
+}
@@ -57,6 +57,11 @@ func (q *Quaternion) SetEulerAngles(yaw, pitch, roll float32) *Quaternion {
 	return q
 }
 
+// Sets the quaternion to the given euler angles, see SetEulerAngles.
+func (q *Quaternion) SetEulerAnglesAngle(yaw, pitch, roll Angle) *Quaternion {
+	return q.SetEulerAngles(float32(yaw.Radians()), float32(pitch.Radians()), float32(roll.Radians()))
+}
+
 func (q *Quaternion) Nor() *Quaternion {
 	l := q.Len2()
 	if l != 0 && Abs(l-1) > NORMALIZATION_TOLERANCE {
@@ -97,9 +102,13 @@ func (q *Quaternion) Idt() *Quaternion {
 // Sets the quaternion components from the given axis and angle around that axis.
 // Angle in radians
 func (q *Quaternion) SetFromAxis(x, y, z, angle float32) *Quaternion {
+	l := Sqrt(x*x + y*y + z*z)
+	if l != 0 {
+		x, y, z = x/l, y/l, z/l
+	}
 	lSin := Sin(angle / 2)
 	lCos := Cos(angle / 2)
-	return q.Set(q.X*lSin, q.Y*lSin, q.Z*lSin, lCos).Nor()
+	return q.Set(x*lSin, y*lSin, z*lSin, lCos).Nor()
 }
 
 func (q *Quaternion) SetFromMatrix(m *Matrix4) *Quaternion {
@@ -107,43 +116,40 @@ func (q *Quaternion) SetFromMatrix(m *Matrix4) *Quaternion {
 }
 
 // Sets the Quaternion from the given x-, y- and z-axis which have to be orthonormal.
+// The axes are the images of the unit axes under the rotation, i.e. the columns of the rotation matrix.
 func (q *Quaternion) SetFromAxes(xx, xy, xz, yx, yy, yz, zx, zy, zz float32) *Quaternion {
-	m00 := float64(xx)
-	m01 := float64(xy)
-	m02 := float64(xz)
-
-	m10 := float64(yx)
-	m11 := float64(yy)
-	m12 := float64(yz)
-
-	m20 := float64(zx)
-	m21 := float64(zy)
-	m22 := float64(zz)
+	// Elements of the rotation matrix by row and column.
+	m00, m01, m02 := float64(xx), float64(yx), float64(zx)
+	m10, m11, m12 := float64(xy), float64(yy), float64(zy)
+	m20, m21, m22 := float64(xz), float64(yz), float64(zz)
 
 	t := m00 + m11 + m22
 
 	var x, y, z, w float64
 	if t >= 0 {
-		s := math.Sqrt(t + 1)
-		w = 0.5 * s
-		s = 0.5 / s
-		x = (m21 - m12) * s
-		y = (m02 - m20) * s
-		z = (m10 - m01) * s
+		s := math.Sqrt(t+1) * 2
+		w = 0.25 * s
+		x = (m21 - m12) / s
+		y = (m02 - m20) / s
+		z = (m10 - m01) / s
 	} else if m00 > m11 && m00 > m22 {
-		s := math.Sqrt(1.0 + m00 + m11 - m22)
-		x = s * 0.5
-		s = 0.5 / s
-		y = (m10 + m01) * s
-		z = (m02 + m20) * s
-		w = (m21 - m12) * s
+		s := math.Sqrt(1.0+m00-m11-m22) * 2
+		w = (m21 - m12) / s
+		x = 0.25 * s
+		y = (m01 + m10) / s
+		z = (m02 + m20) / s
 	} else if m11 > m22 {
-		s := math.Sqrt(1.0 + m22 - m00 - m11)
-		z = s * 0.5
-		s = 0.5 / s
-		x = (m02 + m20) * s
-		y = (m21 + m12) * s
-		w = (m10 - m01) * s
+		s := math.Sqrt(1.0+m11-m00-m22) * 2
+		w = (m02 - m20) / s
+		x = (m01 + m10) / s
+		y = 0.25 * s
+		z = (m12 + m21) / s
+	} else {
+		s := math.Sqrt(1.0+m22-m00-m11) * 2
+		w = (m10 - m01) / s
+		x = (m02 + m20) / s
+		y = (m12 + m21) / s
+		z = 0.25 * s
 	}
 
 	return q.Set(float32(x), float32(y), float32(z), float32(w))
@@ -151,42 +157,71 @@ func (q *Quaternion) SetFromAxes(xx, xy, xz, yx, yy, yz, zx, zy, zz float32) *Qu
 
 // Set this quaternion to the rotation between two vectors.
 func (q *Quaternion) SetFromCross(v1, v2 Vector3) *Quaternion {
-	dot := Clampf(v1.Dot(v2), -1.0, 1.0)
-	angle := ToDegrees(Acos(dot))
+	dot := Clampf(v1.Nor().Dot(v2.Nor()), -1.0, 1.0)
+	angle := Acos(dot)
 	return q.SetFromAxis(v1.Y*v2.Z-v1.Z*v2.Y, v1.Z*v2.X-v1.X*v2.Z, v1.X*v2.Y-v1.Y*v2.X, angle)
 }
 
 // Spherical linear interpolation between this quaternion and the other quaternion, based on the alpha value in the range [0,1].
+// The interpolation takes the shortest path, end is not modified.
 func (q *Quaternion) Slerp(end *Quaternion, alpha float32) *Quaternion {
+	return q.slerp(end, alpha, true)
+}
+
+// Spherical linear interpolation which takes the shortest path only if shortest is set.
+// Otherwise it follows the great arc from q to end even if it is longer than 180 degrees, as squad requires.
+func (q *Quaternion) slerp(end *Quaternion, alpha float32, shortest bool) *Quaternion {
 	if q.Equals(end) {
 		return q
 	}
 
 	result := q.Dot(end)
 
-	if result < 0 {
-		end.Scale(-1)
+	var sign float32 = 1
+	if shortest && result < 0 {
+		sign = -1
 		result = -result
 	}
 
 	scale0 := 1 - alpha
 	scale1 := alpha
 
-	if (1 - result) > 0.1 {
-		theta := Acos(result)
+	// Nearly identical rotations are interpolated linearly, Acos is too inaccurate for them.
+	linear := (1 - result) <= 0.001
+	if !linear {
+		theta := Acos(Clampf(result, -1, 1))
 		invSinTheta := 1 / Sin(theta)
 
 		scale0 = Sin((1-alpha)*theta) * invSinTheta
 		scale1 = Sin(alpha*theta) * invSinTheta
 	}
+	scale1 *= sign
 
 	q.X = (scale0 * q.X) + (scale1 * end.X)
 	q.Y = (scale0 * q.Y) + (scale1 * end.Y)
 	q.Z = (scale0 * q.Z) + (scale1 * end.Z)
 	q.W = (scale0 * q.W) + (scale1 * end.W)
+	if linear {
+		q.Nor()
+	}
 	return q
 }
 
+// Normalized linear interpolation between this quaternion and the other quaternion, based on the alpha value in the range [0,1].
+// Faster than Slerp but the angular velocity is not constant. The interpolation takes the shortest path.
+func (q *Quaternion) Nlerp(end *Quaternion, alpha float32) *Quaternion {
+	scale0 := 1 - alpha
+	scale1 := alpha
+	if q.Dot(end) < 0 {
+		scale1 = -scale1
+	}
+	q.X = (scale0 * q.X) + (scale1 * end.X)
+	q.Y = (scale0 * q.Y) + (scale1 * end.Y)
+	q.Z = (scale0 * q.Z) + (scale1 * end.Z)
+	q.W = (scale0 * q.W) + (scale1 * end.W)
+	return q.Nor()
+}
+
 func (q *Quaternion) Equals(other *Quaternion) bool {
 	if q == other {
 		return true
@@ -214,7 +249,7 @@ func (q *Quaternion) Matrix() *Matrix4 {
 	xy := q.X * q.Y
 	xz := q.X * q.Z
 	xw := q.X * q.W
-	yy := q.Y * q.W
+	yy := q.Y * q.Y
 	yz := q.Y * q.Z
 	yw := q.Y * q.W
 	zz := q.Z * q.Z
@@ -239,3 +274,335 @@ func (q *Quaternion) Matrix() *Matrix4 {
 	matrix.M44 = 1
 	return matrix
 }
+
+// Returns the axis and the angle in radians of the rotation represented by this quaternion.
+// The angle is in the range [0, Pi], for a rotation without angle the x-axis is returned.
+func (q *Quaternion) ToAxisAngle() (axis Vector3, angle float32) {
+	n := q.Cpy().Nor()
+	if n.W < 0 {
+		n.Scale(-1)
+	}
+	s := Sqrt(1 - Clampf(n.W*n.W, 0, 1))
+	if s < 0.000001 {
+		return Vec3(1, 0, 0), 0
+	}
+	return Vec3(n.X/s, n.Y/s, n.Z/s), 2 * Acos(Clampf(n.W, -1, 1))
+}
+
+// Returns the vector rotated by this quaternion which has to be a unit quaternion.
+func (q *Quaternion) Transform(vec Vector3) Vector3 {
+	u := Vec3(q.X, q.Y, q.Z)
+	t := u.Cross(vec).Scale(2)
+	return vec.Add(t.Scale(q.W)).Add(u.Cross(t))
+}
+
+// Sets this quaternion to the rotation which turns the negative z-axis towards forward and the y-axis
+// as close as possible towards up. This is the orientation of a camera created by NewLookAtMatrix4.
+func (q *Quaternion) SetLookRotation(forward, up Vector3) *Quaternion {
+	zAxis := forward.Nor().Invert()
+	if zAxis.IsZero() {
+		return q.Idt()
+	}
+	xAxis := up.Cross(zAxis)
+	if xAxis.Len2() < 0.000001 {
+		// up is parallel to forward, any perpendicular axis will do.
+		if Abs(zAxis.X) < 0.9 {
+			xAxis = Vec3(1, 0, 0).Cross(zAxis)
+		} else {
+			xAxis = Vec3(0, 1, 0).Cross(zAxis)
+		}
+		xAxis = zAxis.Cross(xAxis)
+	}
+	xAxis = xAxis.Nor()
+	yAxis := zAxis.Cross(xAxis)
+	return q.SetFromAxes(xAxis.X, xAxis.Y, xAxis.Z, yAxis.X, yAxis.Y, yAxis.Z, zAxis.X, zAxis.Y, zAxis.Z)
+}
+
+// The order in which Euler angles are applied.
+// The rotations are intrinsic, EulerOrder_XYZ rotates around the x-axis, then the new y-axis and at last the new z-axis.
+// This is the same as the extrinsic rotation around z, y and x.
+// The last six orders are the proper Euler angles which use the first axis twice.
+type EulerOrder int
+
+const (
+	EulerOrder_XYZ EulerOrder = iota
+	EulerOrder_XZY
+	EulerOrder_YXZ
+	EulerOrder_YZX
+	EulerOrder_ZXY
+	EulerOrder_ZYX
+	EulerOrder_XYX
+	EulerOrder_XZX
+	EulerOrder_YXY
+	EulerOrder_YZY
+	EulerOrder_ZXZ
+	EulerOrder_ZYZ
+)
+
+// The axes of each order, 0 is x, 1 is y and 2 is z.
+var eulerOrderAxes = [...][3]int{
+	{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0},
+	{0, 1, 0}, {0, 2, 0}, {1, 0, 1}, {1, 2, 1}, {2, 0, 2}, {2, 1, 2},
+}
+
+// Sets the quaternion to the given Euler angles which are applied in the given order.
+// Values in radians
+func (q *Quaternion) SetEuler(order EulerOrder, first, second, third float32) *Quaternion {
+	axes := eulerOrderAxes[order]
+	angles := [3]float32{first, second, third}
+	q.Idt()
+	for i, axis := range axes {
+		var v [3]float32
+		v[axis] = 1
+		q.Mul(NewQuaternion(0, 0, 0, 1).SetFromAxis(v[0], v[1], v[2], angles[i]))
+	}
+	return q
+}
+
+// Returns the Euler angles in radians of this rotation applied in the given order.
+// The first and third angle are in the range [-Pi, Pi]. The second angle is in the range [-Pi/2, Pi/2]
+// for Tait-Bryan orders (XYZ, ...) and [0, Pi] for proper Euler orders (XYX, ...).
+// In gimbal lock only the sum or difference of the first and third angle is defined,
+// in that case the third angle is zero.
+func (q *Quaternion) ToEuler(order EulerOrder) (first, second, third float32) {
+	// Bernardes and Viollet, "Quaternion to Euler angles conversion: A direct, general and computationally efficient method".
+	// The method works with extrinsic rotations, so the intrinsic order is reversed.
+	axes := eulerOrderAxes[order]
+	i, j, k := axes[2], axes[1], axes[0]
+	proper := i == k
+	if proper {
+		k = 3 - i - j
+	}
+	sign := float64((i - j) * (j - k) * (k - i) / 2)
+
+	n := q.Cpy().Nor()
+	v := [3]float64{float64(n.X), float64(n.Y), float64(n.Z)}
+	w := float64(n.W)
+
+	var a, b, c, d float64
+	if proper {
+		a = w
+		b = v[i]
+		c = v[j]
+		d = v[k] * sign
+	} else {
+		a = w - v[j]
+		b = v[i] + v[k]*sign
+		c = v[j] + w
+		d = v[k]*sign - v[i]
+	}
+
+	angles := [3]float64{}
+	angles[1] = 2 * math.Atan2(math.Hypot(c, d), math.Hypot(a, b))
+	halfSum := math.Atan2(b, a)
+	halfDiff := math.Atan2(d, c)
+
+	// In gimbal lock the whole rotation is put into the extrinsic last angle which is the intrinsic first one.
+	const epsilon = 1e-6
+	switch {
+	case math.Abs(angles[1]) <= epsilon:
+		angles[2] = 2 * halfSum
+	case math.Abs(angles[1]-math.Pi) <= epsilon:
+		angles[2] = 2 * halfDiff
+	default:
+		angles[0] = halfSum - halfDiff
+		angles[2] = halfSum + halfDiff
+	}
+
+	if !proper {
+		angles[2] *= sign
+		angles[1] -= math.Pi / 2
+	}
+	angles[0], angles[2] = angles[2], angles[0]
+
+	for idx := range angles {
+		if angles[idx] > math.Pi {
+			angles[idx] -= 2 * math.Pi
+		} else if angles[idx] < -math.Pi {
+			angles[idx] += 2 * math.Pi
+		}
+	}
+	return float32(angles[0]), float32(angles[1]), float32(angles[2])
+}
+
+// Returns the euler angles set by SetEulerAngles.
+// Values in radians
+func (q *Quaternion) ToEulerAngles() (yaw, pitch, roll float32) {
+	return q.ToEuler(EulerOrder_YXZ)
+}
+
+// Inverts this quaternion, for unit quaternions this is the same as the conjugate.
+func (q *Quaternion) Invert() *Quaternion {
+	l := q.Len2()
+	if l == 0 {
+		return q
+	}
+	return q.Conjugate().Scale(1 / l)
+}
+
+// Sets this quaternion to its exponential.
+func (q *Quaternion) Exp() *Quaternion {
+	vLen := Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	e := Exp(q.W)
+	sin, cos := Sincos(vLen)
+	var scale float32 = e
+	if vLen > 0.000001 {
+		scale = e * sin / vLen
+	}
+	return q.Set(q.X*scale, q.Y*scale, q.Z*scale, e*cos)
+}
+
+// Sets this quaternion to its natural logarithm.
+// For a unit quaternion the result is (axis * angle/2, 0).
+func (q *Quaternion) Log() *Quaternion {
+	l := q.Len()
+	vLen := Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	var scale float32 = 1
+	if l > 0 {
+		scale = 1 / l
+	}
+	if vLen > 0.000001 {
+		scale = Atan2(vLen, q.W) / vLen
+	}
+	w := float32(0)
+	if l > 0 {
+		w = Log(l)
+	}
+	return q.Set(q.X*scale, q.Y*scale, q.Z*scale, w)
+}
+
+// Raises this quaternion to the given power.
+// For a unit quaternion this scales the angle of the rotation by exponent.
+func (q *Quaternion) Pow(exponent float32) *Quaternion {
+	return q.Log().Scale(exponent).Exp()
+}
+
+// Spherical quadrangle interpolation between this quaternion and end, based on the alpha value in the range [0,1].
+// a and b are the inner control points of the segment, see NewSquadControlPoint.
+// The blends don't switch to the shortest path, which would make the curve jump in the middle of segments
+// between keys that are far apart. Use keys with a non-negative dot product, as QuaternionSpline does.
+func (q *Quaternion) Squad(a, b, end *Quaternion, alpha float32) *Quaternion {
+	inner := a.Cpy().slerp(b, alpha, false)
+	q.slerp(end, alpha, false)
+	return q.slerp(inner, 2*alpha*(1-alpha), false)
+}
+
+// Returns the inner control point of the current key for squad interpolation
+// which keeps the angular velocity continuous between the keys prev, current and next.
+func NewSquadControlPoint(prev, current, next *Quaternion) *Quaternion {
+	inv := current.Cpy().Invert()
+	toNext := inv.Cpy().Mul(next)
+	toPrev := inv.Mul(prev)
+	// Use the shortest paths to the neighbour keys.
+	if toNext.W < 0 {
+		toNext.Scale(-1)
+	}
+	if toPrev.W < 0 {
+		toPrev.Scale(-1)
+	}
+	toNext.Log()
+	toPrev.Log()
+	t := NewQuaternion(toNext.X+toPrev.X, toNext.Y+toPrev.Y, toNext.Z+toPrev.Z, toNext.W+toPrev.W)
+	t.Scale(-0.25).Exp()
+	return current.Cpy().Mul(t)
+}
+
+// Splits this rotation into a swing and a twist so that q = swing * twist.
+// The twist is the rotation around the given axis and the swing rotates the axis into its final direction.
+func (q *Quaternion) SwingTwist(axis Vector3) (swing, twist *Quaternion) {
+	axis = axis.Nor()
+	p := axis.Scale(Vec3(q.X, q.Y, q.Z).Dot(axis))
+	twist = NewQuaternion(p.X, p.Y, p.Z, q.W)
+	if twist.Len2() < 0.000001 {
+		// A swing of 180 degrees, the twist is undefined.
+		twist.Idt()
+	} else {
+		twist.Nor()
+	}
+	swing = q.Cpy().Mul(twist.Cpy().Conjugate())
+	return swing, twist
+}
+
+// Returns the signed angle in radians in the range [-Pi, Pi] of the twist of this rotation around the given axis.
+func (q *Quaternion) TwistAngle(axis Vector3) float32 {
+	_, twist := q.SwingTwist(axis)
+	return twistAngle(twist, axis.Nor())
+}
+
+func twistAngle(twist *Quaternion, axis Vector3) float32 {
+	angle := 2 * Atan2(Vec3(twist.X, twist.Y, twist.Z).Dot(axis), twist.W)
+	if angle > Pi {
+		angle -= Pi2
+	} else if angle < -Pi {
+		angle += Pi2
+	}
+	return angle
+}
+
+// Limits the angle of this rotation to maxAngle in radians, the rotation axis is kept.
+// Applied to a swing this is a cone limit.
+func (q *Quaternion) ClampAngle(maxAngle float32) *Quaternion {
+	axis, angle := q.ToAxisAngle()
+	if angle <= maxAngle {
+		return q
+	}
+	return q.SetFromAxis(axis.X, axis.Y, axis.Z, maxAngle)
+}
+
+// Limits the twist of this rotation around the axis to the range [minAngle, maxAngle] in radians.
+// The rotation has to be a pure twist around the axis, see SwingTwist.
+func (q *Quaternion) ClampTwist(axis Vector3, minAngle, maxAngle float32) *Quaternion {
+	axis = axis.Nor()
+	angle := twistAngle(q, axis)
+	clamped := Clampf(angle, minAngle, maxAngle)
+	if clamped == angle {
+		return q
+	}
+	return q.SetFromAxis(axis.X, axis.Y, axis.Z, clamped)
+}
+
+// Limits this rotation like a joint: the swing of the axis is limited to a cone with the half angle maxSwing
+// and the twist around the axis to [minTwist, maxTwist]. Angles in radians
+func (q *Quaternion) ConstrainSwingTwist(axis Vector3, maxSwing, minTwist, maxTwist float32) *Quaternion {
+	swing, twist := q.SwingTwist(axis)
+	swing.ClampAngle(maxSwing)
+	twist.ClampTwist(axis, minTwist, maxTwist)
+	*q = *swing.Mul(twist)
+	return q
+}
+
+// Integrates the angular velocity in world space (axis * radians per second) over dt with a first order step
+// q += dt/2 * (angularVelocity, 0) * q and renormalizes the quaternion.
+// This is cheap but loses accuracy for large steps, see IntegrateExact.
+func (q *Quaternion) Integrate(angularVelocity Vector3, dt float32) *Quaternion {
+	h := dt * 0.5
+	spin := NewQuaternion(angularVelocity.X*h, angularVelocity.Y*h, angularVelocity.Z*h, 0).Mul(q)
+	return q.Set(q.X+spin.X, q.Y+spin.Y, q.Z+spin.Z, q.W+spin.W).Nor()
+}
+
+// Integrates the angular velocity in world space (axis * radians per second) over dt with the exponential map.
+// The result is exact for a constant angular velocity.
+func (q *Quaternion) IntegrateExact(angularVelocity Vector3, dt float32) *Quaternion {
+	h := dt * 0.5
+	delta := NewQuaternion(angularVelocity.X*h, angularVelocity.Y*h, angularVelocity.Z*h, 0).Exp()
+	*q = *delta.Mul(q)
+	return q.Nor()
+}
+
+// Returns the constant angular velocity in world space which rotates from into to within dt.
+// The rotation takes the shortest path.
+func NewAngularVelocity(from, to *Quaternion, dt float32) Vector3 {
+	delta := to.Cpy().Mul(from.Cpy().Invert())
+	if delta.W < 0 {
+		delta.Scale(-1)
+	}
+	delta.Nor().Log()
+	return Vec3(delta.X, delta.Y, delta.Z).Scale(2 / dt)
+}
+
+// Returns the inertia tensor given in body space rotated into world space: R * inertia * R^T.
+// This works the same way for the inverse inertia tensor.
+func (q *Quaternion) RotateInertiaTensor(inertia *Matrix3) *Matrix3 {
+	r := q.Matrix().Matrix3()
+	return r.Mul(inertia).Mul(r.Transpose())
+}
@@ -0,0 +1,62 @@
+package math
+
+// A smooth rotation curve through the given keys.
+// The keys are interpolated with squad and the control points are placed like Catmull-Rom tangents
+// so the angular velocity is continuous across the keys.
+type QuaternionSpline struct {
+	Keys     []Quaternion
+	controls []Quaternion
+}
+
+func NewQuaternionSpline(keys ...Quaternion) *QuaternionSpline {
+	s := &QuaternionSpline{}
+	s.Set(keys...)
+	return s
+}
+
+// Sets the keys of the spline and calculates the control points.
+// Neighbouring keys are flipped if necessary so that each segment takes the shortest path.
+func (s *QuaternionSpline) Set(keys ...Quaternion) *QuaternionSpline {
+	s.Keys = make([]Quaternion, len(keys))
+	copy(s.Keys, keys)
+	for i := range s.Keys {
+		s.Keys[i].Nor()
+		if i > 0 && s.Keys[i].Dot(&s.Keys[i-1]) < 0 {
+			s.Keys[i].Scale(-1)
+		}
+	}
+
+	s.controls = make([]Quaternion, len(s.Keys))
+	for i := range s.Keys {
+		prev := &s.Keys[i]
+		if i > 0 {
+			prev = &s.Keys[i-1]
+		}
+		next := &s.Keys[i]
+		if i < len(s.Keys)-1 {
+			next = &s.Keys[i+1]
+		}
+		s.controls[i] = *NewSquadControlPoint(prev, &s.Keys[i], next)
+	}
+	return s
+}
+
+// The rotation of the spline at t where 0<=t<=1.
+// The keys are spaced evenly along t.
+func (s *QuaternionSpline) ValueAt(t float32) *Quaternion {
+	n := len(s.Keys)
+	if n == 0 {
+		return NewQuaternion(0, 0, 0, 1)
+	}
+	if n == 1 || t <= 0 {
+		return s.Keys[0].Cpy()
+	}
+	if t >= 1 {
+		return s.Keys[n-1].Cpy()
+	}
+
+	segment := t * float32(n-1)
+	i := int(segment)
+	alpha := segment - float32(i)
+	return s.Keys[i].Cpy().Squad(&s.controls[i], &s.controls[i+1], &s.Keys[i+1], alpha)
+}
@@ -0,0 +1,353 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type QuaternionTestSuite struct {
+	rotations []*Quaternion
+}
+
+var _ = Suite(&QuaternionTestSuite{})
+
+func (s *QuaternionTestSuite) SetUpTest(c *C) {
+	s.rotations = []*Quaternion{
+		NewQuaternion(0, 0, 0, 1),
+		NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7),
+		NewQuaternion(0, 0, 0, 1).SetFromAxis(-2, 0.5, 1, 2.5),
+		// Close to 180 degrees around each axis to cover all branches of SetFromAxes.
+		NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0.1, 0.1, 3.1),
+		NewQuaternion(0, 0, 0, 1).SetFromAxis(0.1, 1, 0.1, 3.1),
+		NewQuaternion(0, 0, 0, 1).SetFromAxis(0.1, 0.1, 1, 3.1),
+	}
+}
+
+// Whether both quaternions represent the same rotation, q and -q are the same rotation.
+func sameRotation(q1, q2 *Quaternion) bool {
+	return Abs(Abs(q1.Dot(q2))-1) < 0.0001
+}
+
+// Compares with an absolute tolerance, NearlyEqualFloat32 can't be used to compare against zero.
+func nearlyEqualValues(a, b []float32) bool {
+	for i := range a {
+		if Abs(a[i]-b[i]) > 0.0001 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *QuaternionTestSuite) TestSetFromAxis(c *C) {
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 2, Pi/2)
+	c.Check(q.Len(), EqualsFloat32, float32(1))
+	c.Check(q.Z, EqualsFloat32, Sqrt(0.5))
+	c.Check(q.W, EqualsFloat32, Sqrt(0.5))
+
+	// The result only depends on the arguments and not on the previous value of the quaternion.
+	q = NewQuaternion(1, 2, 3, 4).SetFromAxis(0, 1, 0, Pi/3)
+	c.Check(*q, Equals, *NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, Pi/3))
+	c.Check(q.Transform(Vec3(1, 0, 0)), Vector3Check, Vec3(0.5, 0, -Sqrt(0.75)))
+}
+
+func (s *QuaternionTestSuite) TestMatrix(c *C) {
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 40*DegreeToRadians)
+	c.Check(q.Matrix(), Matrix4Check, NewRotationMatrix4(Vec3(1, 2, 3), 40))
+}
+
+func (s *QuaternionTestSuite) TestSetFromMatrix(c *C) {
+	for _, q := range s.rotations {
+		c.Check(sameRotation(NewQuaternion(0, 0, 0, 1).SetFromMatrix(q.Matrix()), q), Equals, true)
+	}
+}
+
+func (s *QuaternionTestSuite) TestSetFromCross(c *C) {
+	q := NewQuaternion(0, 0, 0, 1).SetFromCross(Vec3(2, 0, 0), Vec3(0, 3, 0))
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2)), Equals, true)
+
+	// The angle between the vectors is used in radians, the first vector is rotated onto the second.
+	from, to := Vec3(1, 0, 1), Vec3(0, 2, 2)
+	q = NewQuaternion(0, 0, 0, 1).SetFromCross(from, to)
+	_, angle := q.ToAxisAngle()
+	c.Check(angle, EqualsFloat32, Pi/3)
+	c.Check(q.Transform(from.Nor()).Distance(to.Nor()) < 0.00001, Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestToAxisAngle(c *C) {
+	axis, angle := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.7).ToAxisAngle()
+	c.Check(axis, Vector3Check, Vec3(1, 2, 3).Nor())
+	c.Check(angle, EqualsFloat32, float32(0.7))
+
+	// The angle is always positive, the axis is flipped instead.
+	axis, angle = NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, -0.7).ToAxisAngle()
+	c.Check(axis, Vector3Check, Vec3(-1, -2, -3).Nor())
+	c.Check(angle, EqualsFloat32, float32(0.7))
+
+	axis, angle = NewQuaternion(0, 0, 0, 1).ToAxisAngle()
+	c.Check(axis, Equals, Vec3(1, 0, 0))
+	c.Check(angle, Equals, float32(0))
+}
+
+func (s *QuaternionTestSuite) TestTransform(c *C) {
+	v := Vec3(1, -2, 0.5)
+	for _, q := range s.rotations {
+		c.Check(q.Transform(v), Vector3Check, q.Matrix().MulVec3(v))
+	}
+}
+
+func (s *QuaternionTestSuite) TestSetLookRotation(c *C) {
+	eye, center, up := Vec3(4, 3, 3), Vec3(0, 0, 0), Vec3(0, 1, 0)
+	q := NewQuaternion(0, 0, 0, 1).SetLookRotation(center.Sub(eye), up)
+	view := NewLookAtMatrix4(eye, center, up)
+	c.Check(nearlyEqualValues(q.Matrix().Matrix3().ToArray(), view.Matrix3().Transpose().ToArray()), Equals, true)
+	c.Check(q.Transform(Vec3(0, 0, -1)), Vector3Check, center.Sub(eye).Nor())
+
+	// Forward parallel to up still results in a valid rotation.
+	q = NewQuaternion(0, 0, 0, 1).SetLookRotation(Vec3(0, 2, 0), up)
+	c.Check(q.Len(), EqualsFloat32, float32(1))
+	c.Check(nearlyEqualValues(vector3Values(q.Transform(Vec3(0, 0, -1))), []float32{0, 1, 0}), Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestEuler(c *C) {
+	for order := EulerOrder_XYZ; order <= EulerOrder_ZYZ; order++ {
+		q := NewQuaternion(0, 0, 0, 1).SetEuler(order, 0.3, 0.7, -1.1)
+		first, second, third := q.ToEuler(order)
+		c.Check(first, EqualsFloat32, float32(0.3))
+		c.Check(second, EqualsFloat32, float32(0.7))
+		c.Check(third, EqualsFloat32, float32(-1.1))
+
+		for _, r := range s.rotations {
+			first, second, third = r.ToEuler(order)
+			c.Check(sameRotation(NewQuaternion(0, 0, 0, 1).SetEuler(order, first, second, third), r), Equals, true)
+		}
+	}
+}
+
+func (s *QuaternionTestSuite) TestEulerGimbalLock(c *C) {
+	for order := EulerOrder_XYZ; order <= EulerOrder_ZYZ; order++ {
+		locked := []float32{Pi / 2, -Pi / 2}
+		if order >= EulerOrder_XYX {
+			locked = []float32{0, Pi}
+		}
+		for _, second := range locked {
+			q := NewQuaternion(0, 0, 0, 1).SetEuler(order, 0.3, second, -1.1)
+			first, second, third := q.ToEuler(order)
+			c.Check(third, Equals, float32(0))
+			c.Check(sameRotation(NewQuaternion(0, 0, 0, 1).SetEuler(order, first, second, third), q), Equals, true)
+		}
+	}
+}
+
+func (s *QuaternionTestSuite) TestEulerAngles(c *C) {
+	q := NewQuaternion(0, 0, 0, 1).SetEulerAngles(0.3, 0.7, -1.1)
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetEuler(EulerOrder_YXZ, 0.3, 0.7, -1.1)), Equals, true)
+	yaw, pitch, roll := q.ToEulerAngles()
+	c.Check(yaw, EqualsFloat32, float32(0.3))
+	c.Check(pitch, EqualsFloat32, float32(0.7))
+	c.Check(roll, EqualsFloat32, float32(-1.1))
+}
+
+func vector3Values(v Vector3) []float32 {
+	return []float32{v.X, v.Y, v.Z}
+}
+
+// The angle in radians of the rotation between both quaternions.
+// Computed from the vector part since Acos is inaccurate for small angles.
+func angleBetween(q1, q2 *Quaternion) float32 {
+	d := q1.Cpy().Invert().Mul(q2)
+	return 2 * Atan2(Sqrt(d.X*d.X+d.Y*d.Y+d.Z*d.Z), Abs(d.W))
+}
+
+func (s *QuaternionTestSuite) TestExpLog(c *C) {
+	for _, q := range s.rotations {
+		c.Check(sameRotation(q.Cpy().Log().Exp(), q), Equals, true)
+	}
+
+	l := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 2, 0, 1.2).Log()
+	c.Check(l.Y, EqualsFloat32, float32(0.6))
+	c.Check(Abs(l.W) < 0.00001, Equals, true)
+
+	c.Check(*NewQuaternion(0, 0, 0, 1).Log().Exp(), Equals, *NewQuaternion(0, 0, 0, 1))
+}
+
+func (s *QuaternionTestSuite) TestPow(c *C) {
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 1.2).Pow(0.5)
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 3, 0.6)), Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestInvert(c *C) {
+	q := NewQuaternion(1, 2, 3, 4)
+	c.Check(sameRotation(q.Cpy().Mul(q.Cpy().Invert()), NewQuaternion(0, 0, 0, 1)), Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestSlerp(c *C) {
+	start := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.2)
+	end := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 1.4)
+	flipped := end.Cpy().Scale(-1)
+
+	q := start.Cpy().Slerp(flipped, 0.5)
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.8)), Equals, true)
+	c.Check(*flipped, Equals, *end.Cpy().Scale(-1))
+
+	// Nearly identical rotations are still unit quaternions.
+	q = start.Cpy().Slerp(NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.2001), 0.5)
+	c.Check(q.Len(), EqualsFloat32, float32(1))
+}
+
+func (s *QuaternionTestSuite) TestNlerp(c *C) {
+	start := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.2)
+	end := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 1.4)
+
+	c.Check(sameRotation(start.Cpy().Nlerp(end, 0), start), Equals, true)
+	c.Check(sameRotation(start.Cpy().Nlerp(end, 1), end), Equals, true)
+	q := start.Cpy().Nlerp(end.Cpy().Scale(-1), 0.5)
+	c.Check(q.Len(), EqualsFloat32, float32(1))
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.8)), Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestSquad(c *C) {
+	q0 := NewQuaternion(0, 0, 0, 1)
+	q1 := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.8)
+	q2 := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 1, 0, 1.6)
+	q3 := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 0, 0.4)
+	a := NewSquadControlPoint(q0, q1, q2)
+	b := NewSquadControlPoint(q1, q2, q3)
+
+	c.Check(sameRotation(q1.Cpy().Squad(a, b, q2, 0), q1), Equals, true)
+	c.Check(sameRotation(q1.Cpy().Squad(a, b, q2, 1), q2), Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestQuaternionSpline(c *C) {
+	keys := []Quaternion{
+		*NewQuaternion(0, 0, 0, 1),
+		*NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.8),
+		*NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 1, 0, 1.6).Scale(-1),
+		*NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 0, 0.4),
+	}
+	spline := NewQuaternionSpline(keys...)
+	for i := range keys {
+		c.Check(sameRotation(spline.ValueAt(float32(i)/3), &keys[i]), Equals, true)
+	}
+
+	// The angular velocity is the same on both sides of a key.
+	const h = 0.005
+	for _, t := range []float32{1.0 / 3, 2.0 / 3} {
+		before := angleBetween(spline.ValueAt(t-h), spline.ValueAt(t))
+		after := angleBetween(spline.ValueAt(t), spline.ValueAt(t+h))
+		c.Check(Abs(before-after)/after < 0.05, Equals, true)
+	}
+}
+
+func (s *QuaternionTestSuite) TestQuaternionSplineLargeAngles(c *C) {
+	spline := NewQuaternionSpline(*NewQuaternion(0, 0, 0, 1), *NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, 3))
+	c.Check(spline.ValueAt(0.49).Dot(spline.ValueAt(0.5)) > 0.99, Equals, true)
+
+	spline = NewQuaternionSpline(
+		*NewQuaternion(0, 0, 0, 1),
+		*NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, 3),
+		*NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 0, 2.8),
+		*NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 2, 0, 3.1),
+	)
+	// Every step along the curve is a small rotation.
+	const steps = 600
+	prev := spline.ValueAt(0)
+	for i := 1; i <= steps; i++ {
+		q := spline.ValueAt(float32(i) / steps)
+		c.Check(angleBetween(prev, q) < 0.05, Equals, true, Commentf("t=%v", float32(i)/steps))
+		prev = q
+	}
+}
+
+func (s *QuaternionTestSuite) TestSwingTwist(c *C) {
+	axis := Vec3(0, 1, 0)
+	swingIn := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 0.5)
+	twistIn := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, -0.8)
+	q := swingIn.Cpy().Mul(twistIn)
+
+	swing, twist := q.SwingTwist(axis)
+	c.Check(sameRotation(swing, swingIn), Equals, true)
+	c.Check(sameRotation(twist, twistIn), Equals, true)
+	c.Check(sameRotation(swing.Cpy().Mul(twist), q), Equals, true)
+	c.Check(q.TwistAngle(axis), EqualsFloat32, float32(-0.8))
+
+	// 180 degree swing has no defined twist.
+	swing, twist = NewQuaternion(1, 0, 0, 0).SwingTwist(axis)
+	c.Check(*twist, Equals, *NewQuaternion(0, 0, 0, 1))
+	c.Check(*swing, Equals, *NewQuaternion(1, 0, 0, 0))
+}
+
+func (s *QuaternionTestSuite) TestClampAngle(c *C) {
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 1.5).ClampAngle(0.5)
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 0.5)), Equals, true)
+
+	q = NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 0.2).ClampAngle(0.5)
+	c.Check(sameRotation(q, NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 0.2)), Equals, true)
+}
+
+func (s *QuaternionTestSuite) TestClampTwist(c *C) {
+	axis := Vec3(0, 2, 0)
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, -0.8).ClampTwist(axis, -0.3, 0.3)
+	c.Check(q.TwistAngle(axis), EqualsFloat32, float32(-0.3))
+	q = NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, 0.8).ClampTwist(axis, -0.3, 0.3)
+	c.Check(q.TwistAngle(axis), EqualsFloat32, float32(0.3))
+}
+
+func (s *QuaternionTestSuite) TestConstrainSwingTwist(c *C) {
+	axis := Vec3(0, 1, 0)
+	swingIn := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 1.2)
+	twistIn := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, -0.8)
+	q := swingIn.Cpy().Mul(twistIn).ConstrainSwingTwist(axis, 0.6, -0.3, 0.5)
+
+	expected := NewQuaternion(0, 0, 0, 1).SetFromAxis(1, 0, 1, 0.6).Mul(NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 1, 0, -0.3))
+	c.Check(sameRotation(q, expected), Equals, true)
+	c.Check(q.Transform(axis).Dot(axis), EqualsFloat32, Cos(0.6))
+}
+
+func (s *QuaternionTestSuite) TestIntegrate(c *C) {
+	angularVelocity := Vec3(0, 0, Pi)
+	expected := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2)
+
+	exact := NewQuaternion(0, 0, 0, 1).IntegrateExact(angularVelocity, 0.5)
+	c.Check(sameRotation(exact, expected), Equals, true)
+
+	firstOrder := NewQuaternion(0, 0, 0, 1)
+	for i := 0; i < 500; i++ {
+		firstOrder.Integrate(angularVelocity, 0.001)
+	}
+	c.Check(firstOrder.Len(), EqualsFloat32, float32(1))
+	c.Check(Abs(firstOrder.Dot(expected)) > 0.9999, Equals, true)
+
+	// The angular velocity is given in world space, so it is applied after the current orientation.
+	for _, q := range s.rotations {
+		rotated := q.Cpy().IntegrateExact(angularVelocity, 0.5)
+		c.Check(sameRotation(rotated, expected.Cpy().Mul(q)), Equals, true)
+	}
+}
+
+func (s *QuaternionTestSuite) TestNewAngularVelocity(c *C) {
+	angularVelocity := Vec3(0.5, -1, 2)
+	for _, q := range s.rotations {
+		to := q.Cpy().IntegrateExact(angularVelocity, 0.1)
+		c.Check(NewAngularVelocity(q, to, 0.1), Vector3Check, angularVelocity)
+		// Both signs of a quaternion describe the same orientation.
+		c.Check(NewAngularVelocity(q, to.Scale(-1), 0.1), Vector3Check, angularVelocity)
+	}
+}
+
+func (s *QuaternionTestSuite) TestRotateInertiaTensor(c *C) {
+	inertia := NewMatrix3(1, 0, 0, 0, 2, 0, 0, 0, 3)
+	q := NewQuaternion(0, 0, 0, 1).SetFromAxis(0, 0, 1, Pi/2)
+	world := q.RotateInertiaTensor(inertia)
+	c.Check(nearlyEqualValues(world.ToArray(), []float32{2, 0, 0, 0, 1, 0, 0, 0, 3}), Equals, true)
+
+	// The angular momentum of a rotation around a body axis is rotated with the body.
+	for _, q := range s.rotations {
+		world := q.RotateInertiaTensor(inertia)
+		axis := q.Transform(Vec3(0, 1, 0))
+		momentum := []float32{
+			world.M11*axis.X + world.M21*axis.Y + world.M31*axis.Z,
+			world.M12*axis.X + world.M22*axis.Y + world.M32*axis.Z,
+			world.M13*axis.X + world.M23*axis.Y + world.M33*axis.Z,
+		}
+		c.Check(nearlyEqualValues(momentum, []float32{2 * axis.X, 2 * axis.Y, 2 * axis.Z}), Equals, true)
+	}
+}
@@ -0,0 +1,90 @@
+package math
+
+// A rectangle with integer coordinates, e.g. a region of a tile map.
+// It covers the cells from (X, Y) up to but not including (X+Width, Y+Height).
+type Rectanglei struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+func Recti(x, y, width, height int) *Rectanglei {
+	return &Rectanglei{x, y, width, height}
+}
+
+// Returns the rectangle spanning from min up to but not including max.
+func NewRectangleiFromPoints(min, max Point) *Rectanglei {
+	return &Rectanglei{min.X, min.Y, max.X - min.X, max.Y - min.Y}
+}
+
+func (r *Rectanglei) Cpy() *Rectanglei {
+	return &Rectanglei{r.X, r.Y, r.Width, r.Height}
+}
+
+func (r *Rectanglei) Set(x, y, width, height int) *Rectanglei {
+	r.X = x
+	r.Y = y
+	r.Width = width
+	r.Height = height
+	return r
+}
+
+// The first cell of the rectangle.
+func (r *Rectanglei) Min() Point {
+	return Point{r.X, r.Y}
+}
+
+// The cell after the last cell of the rectangle.
+func (r *Rectanglei) Max() Point {
+	return Point{r.X + r.Width, r.Y + r.Height}
+}
+
+// The number of cells
+func (r *Rectanglei) Area() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.Width * r.Height
+}
+
+func (r *Rectanglei) IsEmpty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+func (r *Rectanglei) Contains(p Point) bool {
+	return p.X >= r.X && p.X < r.X+r.Width && p.Y >= r.Y && p.Y < r.Y+r.Height
+}
+
+// Returns the cell of the rectangle nearest to the point, the rectangle must not be empty.
+func (r *Rectanglei) ClampPoint(p Point) Point {
+	return p.Clamp(r.Min(), r.Max().Sub(Point{1, 1}))
+}
+
+// Returns whether all cells of rect are in this rectangle.
+func (r *Rectanglei) ContainsRec(rect *Rectanglei) bool {
+	return rect.X >= r.X && rect.X+rect.Width <= r.X+r.Width && rect.Y >= r.Y && rect.Y+rect.Height <= r.Y+r.Height
+}
+
+// Returns whether both rectangles share at least one cell.
+func (r *Rectanglei) Overlaps(rect *Rectanglei) bool {
+	return !r.Intersection(rect).IsEmpty()
+}
+
+// Returns the cells which are in both rectangles, the result is empty if they don't overlap.
+func (r *Rectanglei) Intersection(rect *Rectanglei) *Rectanglei {
+	min := r.Min().Max(rect.Min())
+	max := r.Max().Min(rect.Max())
+	return NewRectangleiFromPoints(min, min.Max(max))
+}
+
+// Merges this rectangle with the other rectangle.
+func (r *Rectanglei) Merge(rect *Rectanglei) *Rectanglei {
+	min := r.Min().Min(rect.Min())
+	max := r.Max().Max(rect.Max())
+	return r.Set(min.X, min.Y, max.X-min.X, max.Y-min.Y)
+}
+
+func (r *Rectanglei) Rectangle() *Rectangle {
+	return Rect(float32(r.X), float32(r.Y), float32(r.Width), float32(r.Height))
+}
@@ -8,3 +8,14 @@ type Segment struct {
 func NewSegment(a, b Vector3) *Segment {
 	return &Segment{a, b}
 }
+
+// Returns the point of the segment nearest to the point.
+func (s *Segment) ClosestPoint(point Vector3) Vector3 {
+	d := s.B.Sub(s.A)
+	dd := d.Dot(d)
+	if dd == 0 {
+		return s.A
+	}
+	t := Clampf(point.Sub(s.A).Dot(d)/dd, 0, 1)
+	return s.A.Add(d.Scale(t))
+}
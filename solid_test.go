@@ -0,0 +1,91 @@
+package math
+
+import (
+	"math/rand"
+
+	. "launchpad.net/gocheck"
+)
+
+// The queries shared by Capsule, Cylinder and Cone.
+type solid interface {
+	Volume() float32
+	Bounds() BoundingBox
+	ContainsVec(point Vector3) bool
+	ClosestPoint(point Vector3) Vector3
+	Support(direction Vector3) Vector3
+	IntersectRay(ray *Ray) (float32, bool)
+}
+
+// Checks the queries of the solid against each other with random points and rays around its bounds.
+func checkSolid(c *C, s solid, seed int64) {
+	rnd := rand.New(rand.NewSource(seed))
+	bounds := s.Bounds()
+	size := bounds.Max.Sub(bounds.Min)
+	random := func() Vector3 {
+		return bounds.Min.Sub(size.Scale(0.5)).Add(size.Mul(Vec3(rnd.Float32(), rnd.Float32(), rnd.Float32())).Scale(2))
+	}
+
+	// Estimate the volume by sampling the bounds, this also checks that the bounds contain the solid.
+	inside := 0
+	const samples = 20000
+	for i := 0; i < samples; i++ {
+		p := random()
+		if s.ContainsVec(p) {
+			inside++
+			c.Assert(p.Max(bounds.Min).Min(bounds.Max), Equals, p)
+		}
+	}
+	sampled := float32(inside) / samples * 8 * size.X * size.Y * size.Z
+	c.Check(Abs(sampled-s.Volume()) < s.Volume()*0.1, Equals, true)
+
+	for i := 0; i < 200; i++ {
+		p := random()
+		closest := s.ClosestPoint(p)
+		if s.ContainsVec(p) {
+			c.Check(closest, Equals, p)
+		} else {
+			c.Check(s.ClosestPoint(closest).Distance(closest) < 0.0001, Equals, true)
+			c.Check(s.ContainsVec(closest.Lerp(p, 0.001)), Equals, false)
+			// No other point of the solid is nearer.
+			for j := 0; j < 20; j++ {
+				other := random()
+				if s.ContainsVec(other) {
+					c.Check(other.Distance(p) >= closest.Distance(p)-0.0001, Equals, true)
+				}
+			}
+		}
+
+		direction := random().Sub(random()).Nor()
+		support := s.Support(direction)
+		c.Check(s.ContainsVec(support.Lerp(s.ClosestPoint(bounds.Min.Lerp(bounds.Max, 0.5)), 0.001)), Equals, true)
+		for j := 0; j < 20; j++ {
+			other := random()
+			if s.ContainsVec(other) {
+				c.Check(other.Dot(direction) <= support.Dot(direction)+0.0001, Equals, true)
+			}
+		}
+
+		ray := NewRay(p, random().Sub(p))
+		distance, ok := s.IntersectRay(ray)
+		if s.ContainsVec(p) {
+			c.Check(ok, Equals, true)
+			c.Check(distance, Equals, float32(0))
+			continue
+		}
+		// Walk along the ray to find where it enters the solid.
+		entered := float32(-1)
+		for t := float32(0); t <= 1; t += 0.001 {
+			if s.ContainsVec(ray.GetEndPoint(t)) {
+				entered = t
+				break
+			}
+		}
+		if entered >= 0 {
+			c.Check(ok, Equals, true)
+			c.Check(Abs(distance-entered) < 0.002, Equals, true)
+		} else if ok {
+			// The ray hits the solid beyond the sampled part or grazes it between two samples.
+			c.Check(distance > 0.99 || s.ContainsVec(s.ClosestPoint(ray.GetEndPoint(distance))), Equals, true)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package math
+
+// The shared implementation of SpatialHash and SpatialHash2. SpatialHash2 uses cells and bounds with z = 0.
+type spatialHash struct {
+	cellSize float32
+	cells    map[Point3][]int
+	pool     itemPool
+	items    []spatialHashItem
+	// Incremented for every query to report items which span several cells only once.
+	stamp int
+}
+
+type spatialHashItem struct {
+	bounds   BoundingBox
+	min, max Point3
+	stamp    int
+}
+
+func newSpatialHash(cellSize float32) *spatialHash {
+	return &spatialHash{cellSize: cellSize, cells: make(map[Point3][]int)}
+}
+
+func (h *spatialHash) cell(point Vector3) Point3 {
+	return Pt3(int(Floor(point.X/h.cellSize)), int(Floor(point.Y/h.cellSize)), int(Floor(point.Z/h.cellSize)))
+}
+
+func (h *spatialHash) insert(bounds *BoundingBox, data interface{}) int {
+	item := h.pool.insert(data)
+	if item == len(h.items) {
+		h.items = append(h.items, spatialHashItem{})
+	}
+	h.items[item] = spatialHashItem{bounds: *bounds, min: h.cell(bounds.Min), max: h.cell(bounds.Max)}
+	h.addCells(item)
+	return item
+}
+
+func (h *spatialHash) remove(item int) {
+	h.pool.remove(item)
+	h.removeCells(item)
+	h.items[item] = spatialHashItem{}
+}
+
+func (h *spatialHash) update(item int, bounds *BoundingBox) {
+	h.pool.check(item)
+	it := &h.items[item]
+	it.bounds = *bounds
+	min, max := h.cell(bounds.Min), h.cell(bounds.Max)
+	if min != it.min || max != it.max {
+		h.removeCells(item)
+		it.min, it.max = min, max
+		h.addCells(item)
+	}
+}
+
+func (h *spatialHash) bounds(item int) *BoundingBox {
+	h.pool.check(item)
+	return &h.items[item].bounds
+}
+
+func (h *spatialHash) addCells(item int) {
+	it := &h.items[item]
+	for x := it.min.X; x <= it.max.X; x++ {
+		for y := it.min.Y; y <= it.max.Y; y++ {
+			for z := it.min.Z; z <= it.max.Z; z++ {
+				cell := Pt3(x, y, z)
+				h.cells[cell] = append(h.cells[cell], item)
+			}
+		}
+	}
+}
+
+func (h *spatialHash) removeCells(item int) {
+	it := &h.items[item]
+	for x := it.min.X; x <= it.max.X; x++ {
+		for y := it.min.Y; y <= it.max.Y; y++ {
+			for z := it.min.Z; z <= it.max.Z; z++ {
+				cell := Pt3(x, y, z)
+				items := h.cells[cell]
+				for i, other := range items {
+					if other == item {
+						items[i] = items[len(items)-1]
+						items = items[:len(items)-1]
+						break
+					}
+				}
+				if len(items) == 0 {
+					delete(h.cells, cell)
+				} else {
+					h.cells[cell] = items
+				}
+			}
+		}
+	}
+}
+
+func (h *spatialHash) query(box *BoundingBox, result []int) []int {
+	h.stamp++
+	min, max := h.cell(box.Min), h.cell(box.Max)
+	for x := min.X; x <= max.X; x++ {
+		for y := min.Y; y <= max.Y; y++ {
+			for z := min.Z; z <= max.Z; z++ {
+				for _, item := range h.cells[Pt3(x, y, z)] {
+					it := &h.items[item]
+					if it.stamp != h.stamp && it.bounds.Overlaps(box) {
+						it.stamp = h.stamp
+						result = append(result, item)
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// Visits the cells crossed by the ray from origin along direction up to maxDistance in multiples of
+// the direction with the algorithm of Amanatides and Woo. visit gets the distance at which the ray enters
+// the cell and stops the traversal by returning false.
+func (h *spatialHash) traverse(origin, direction Vector3, maxDistance float32, visit func(cell Point3, distance float32) bool) {
+	start := h.cell(origin)
+	cell := [3]int{start.X, start.Y, start.Z}
+	var step [3]int
+	var tMax, tDelta [3]float32
+	for axis := 0; axis < 3; axis++ {
+		o, d := origin.Get(axis), direction.Get(axis)
+		switch {
+		case d > 0:
+			step[axis] = 1
+			tMax[axis] = (float32(cell[axis]+1)*h.cellSize - o) / d
+			tDelta[axis] = h.cellSize / d
+		case d < 0:
+			step[axis] = -1
+			tMax[axis] = (float32(cell[axis])*h.cellSize - o) / d
+			tDelta[axis] = -h.cellSize / d
+		default:
+			tMax[axis] = Inf(1)
+			tDelta[axis] = Inf(1)
+		}
+	}
+	var t float32
+	for {
+		if !visit(Pt3(cell[0], cell[1], cell[2]), t) {
+			return
+		}
+		axis := 0
+		if tMax[1] < tMax[axis] {
+			axis = 1
+		}
+		if tMax[2] < tMax[axis] {
+			axis = 2
+		}
+		t = tMax[axis]
+		// A zero direction never leaves the first cell, even for an infinite maxDistance.
+		if IsInf(t, 1) || t > maxDistance {
+			return
+		}
+		cell[axis] += step[axis]
+		tMax[axis] += tDelta[axis]
+	}
+}
+
+// A sparse uniform grid of cubic cells for items with BoundingBox bounds. Only the cells which
+// contain items are stored, so the grid is unbounded. Items are registered in all cells they overlap,
+// so the cell size should be about the size of the typical item.
+// Items are identified by the id returned from Insert, see itemPool.
+type SpatialHash struct {
+	hash *spatialHash
+}
+
+// Returns an empty spatial hash with cubic cells with an edge length of cellSize.
+func NewSpatialHash(cellSize float32) *SpatialHash {
+	return &SpatialHash{newSpatialHash(cellSize)}
+}
+
+// The edge length of the cells
+func (h *SpatialHash) CellSize() float32 {
+	return h.hash.cellSize
+}
+
+// The number of items
+func (h *SpatialHash) Len() int {
+	return h.hash.pool.count
+}
+
+// Returns the coordinates of the cell which contains the point.
+func (h *SpatialHash) Cell(point Vector3) Point3 {
+	return h.hash.cell(point)
+}
+
+// Returns the bounds of the cell.
+func (h *SpatialHash) CellBounds(cell Point3) BoundingBox {
+	min := Vec3(float32(cell.X), float32(cell.Y), float32(cell.Z)).Scale(h.hash.cellSize)
+	return BoundingBox{Min: min, Max: min.Add(Vec3(h.hash.cellSize, h.hash.cellSize, h.hash.cellSize))}
+}
+
+// Inserts an item with the bounds and returns its id. data can be used to associate an object with the item.
+func (h *SpatialHash) Insert(bounds BoundingBox, data interface{}) int {
+	return h.hash.insert(&bounds, data)
+}
+
+// Removes the item, see itemPool.
+func (h *SpatialHash) Remove(item int) {
+	h.hash.remove(item)
+}
+
+// Updates the bounds of the item after it moved or changed its size.
+func (h *SpatialHash) Update(item int, bounds BoundingBox) {
+	h.hash.update(item, &bounds)
+}
+
+// The bounds of the item.
+func (h *SpatialHash) Bounds(item int) BoundingBox {
+	return *h.hash.bounds(item)
+}
+
+// The data given when the item was inserted.
+func (h *SpatialHash) Data(item int) interface{} {
+	return h.hash.pool.get(item)
+}
+
+// Appends the items whose bounds overlap the box to result.
+func (h *SpatialHash) Query(box *BoundingBox, result []int) []int {
+	return h.hash.query(box, result)
+}
+
+// Appends the items registered in the cell to result.
+func (h *SpatialHash) QueryCell(cell Point3, result []int) []int {
+	return append(result, h.hash.cells[cell]...)
+}
+
+// Visits the cells crossed by the ray in order, up to maxDistance in multiples of the ray direction.
+// visit gets the distance at which the ray enters the cell and stops the traversal by returning false.
+// The cells are visited whether they contain items or not, so this can also be used to walk a voxel grid.
+func (h *SpatialHash) TraverseRay(ray *Ray, maxDistance float32, visit func(cell Point3, distance float32) bool) {
+	h.hash.traverse(ray.Origin, ray.Direction, maxDistance, visit)
+}
+
+// Visits the cells crossed by the segment in order from A to B, see TraverseRay.
+// The distance is in the range [0,1] along the segment.
+func (h *SpatialHash) TraverseSegment(segment *Segment, visit func(cell Point3, distance float32) bool) {
+	h.hash.traverse(segment.A, segment.B.Sub(segment.A), 1, visit)
+}
+
+// A sparse uniform grid of square cells for items with Rectangle bounds, the 2D counterpart of SpatialHash.
+// Items are identified by the id returned from Insert, see itemPool.
+type SpatialHash2 struct {
+	hash *spatialHash
+}
+
+// Returns an empty spatial hash with square cells with an edge length of cellSize.
+func NewSpatialHash2(cellSize float32) *SpatialHash2 {
+	return &SpatialHash2{newSpatialHash(cellSize)}
+}
+
+// The edge length of the cells
+func (h *SpatialHash2) CellSize() float32 {
+	return h.hash.cellSize
+}
+
+// The number of items
+func (h *SpatialHash2) Len() int {
+	return h.hash.pool.count
+}
+
+// Returns the coordinates of the cell which contains the point.
+func (h *SpatialHash2) Cell(point Vector2) Point {
+	cell := h.hash.cell(Vec3(point.X, point.Y, 0))
+	return Pt(cell.X, cell.Y)
+}
+
+// Returns the bounds of the cell.
+func (h *SpatialHash2) CellBounds(cell Point) Rectangle {
+	size := h.hash.cellSize
+	return Rectangle{float32(cell.X) * size, float32(cell.Y) * size, size, size}
+}
+
+// Inserts an item with the bounds and returns its id. data can be used to associate an object with the item.
+func (h *SpatialHash2) Insert(bounds Rectangle, data interface{}) int {
+	box := rectangleBounds(&bounds)
+	return h.hash.insert(&box, data)
+}
+
+// Removes the item, see itemPool.
+func (h *SpatialHash2) Remove(item int) {
+	h.hash.remove(item)
+}
+
+// Updates the bounds of the item after it moved or changed its size.
+func (h *SpatialHash2) Update(item int, bounds Rectangle) {
+	box := rectangleBounds(&bounds)
+	h.hash.update(item, &box)
+}
+
+// The bounds of the item.
+func (h *SpatialHash2) Bounds(item int) Rectangle {
+	bounds := h.hash.bounds(item)
+	return Rectangle{bounds.Min.X, bounds.Min.Y, bounds.Max.X - bounds.Min.X, bounds.Max.Y - bounds.Min.Y}
+}
+
+// The data given when the item was inserted.
+func (h *SpatialHash2) Data(item int) interface{} {
+	return h.hash.pool.get(item)
+}
+
+// Appends the items whose bounds overlap the rectangle to result.
+func (h *SpatialHash2) Query(rect *Rectangle, result []int) []int {
+	box := rectangleBounds(rect)
+	return h.hash.query(&box, result)
+}
+
+// Appends the items registered in the cell to result.
+func (h *SpatialHash2) QueryCell(cell Point, result []int) []int {
+	return append(result, h.hash.cells[Pt3(cell.X, cell.Y, 0)]...)
+}
+
+// Visits the cells crossed by the ray from origin along direction in order, up to maxDistance in multiples
+// of the direction. visit gets the distance at which the ray enters the cell and stops the traversal by returning false.
+// The cells are visited whether they contain items or not, so this can also be used to walk a tile map.
+func (h *SpatialHash2) TraverseRay(origin, direction Vector2, maxDistance float32, visit func(cell Point, distance float32) bool) {
+	h.hash.traverse(Vec3(origin.X, origin.Y, 0), Vec3(direction.X, direction.Y, 0), maxDistance, func(cell Point3, distance float32) bool {
+		return visit(Pt(cell.X, cell.Y), distance)
+	})
+}
+
+// Visits the cells crossed by the line from a to b in order, see TraverseRay.
+// The distance is in the range [0,1] along the line.
+func (h *SpatialHash2) TraverseSegment(a, b Vector2, visit func(cell Point, distance float32) bool) {
+	h.TraverseRay(a, b.Sub(a), 1, visit)
+}
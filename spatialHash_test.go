@@ -0,0 +1,158 @@
+package math
+
+import (
+	"math/rand"
+
+	. "launchpad.net/gocheck"
+)
+
+type SpatialHashTestSuite struct{}
+
+var _ = Suite(&SpatialHashTestSuite{})
+
+func (s *SpatialHashTestSuite) TestQuery(c *C) {
+	rnd := rand.New(rand.NewSource(1))
+	hash := NewSpatialHash(10)
+	c.Check(hash.CellSize(), Equals, float32(10))
+	bounds := make(map[int]BoundingBox)
+	var items []int
+	for _, box := range randomBounds(rnd, 500, 15) {
+		box.Min = box.Min.Sub(Vec3(50, 50, 50))
+		box.Max = box.Max.Sub(Vec3(50, 50, 50))
+		item := hash.Insert(box, nil)
+		items = append(items, item)
+		bounds[item] = box
+	}
+	expected := func(box *BoundingBox) []int {
+		var result []int
+		for item, b := range bounds {
+			if b.Overlaps(box) {
+				result = append(result, item)
+			}
+		}
+		return sortedInts(result)
+	}
+	box := &BoundingBox{Min: Vec3(-20, -15, 0), Max: Vec3(5, 10, 25)}
+	c.Assert(len(expected(box)) > 0, Equals, true)
+	c.Check(sortedInts(hash.Query(box, nil)), DeepEquals, expected(box))
+
+	for i := 0; i < 1000; i++ {
+		item := items[rnd.Intn(len(items))]
+		d := Vec3(rnd.Float32()-0.5, rnd.Float32()-0.5, rnd.Float32()-0.5).Scale(20)
+		b := BoundingBox{Min: bounds[item].Min.Add(d), Max: bounds[item].Max.Add(d)}
+		hash.Update(item, b)
+		bounds[item] = b
+	}
+	c.Check(sortedInts(hash.Query(box, nil)), DeepEquals, expected(box))
+	c.Check(hash.Bounds(items[3]), Equals, bounds[items[3]])
+
+	for _, item := range items[:250] {
+		hash.Remove(item)
+		delete(bounds, item)
+	}
+	c.Check(hash.Len(), Equals, 250)
+	c.Check(sortedInts(hash.Query(box, nil)), DeepEquals, expected(box))
+	c.Check(func() { hash.Remove(items[0]) }, PanicMatches, "invalid item id")
+	c.Check(func() { hash.Update(items[0], *box) }, PanicMatches, "invalid item id")
+	c.Check(func() { hash.Bounds(-1) }, PanicMatches, "invalid item id")
+	c.Check(hash.Len(), Equals, 250)
+	for _, item := range items[250:] {
+		hash.Remove(item)
+	}
+	c.Check(hash.hash.cells, HasLen, 0)
+}
+
+func (s *SpatialHashTestSuite) TestCells(c *C) {
+	hash := NewSpatialHash(2)
+	c.Check(hash.Cell(Vec3(3, -0.5, 4)), Equals, Pt3(1, -1, 2))
+	c.Check(hash.CellBounds(Pt3(1, -1, 2)), Equals, BoundingBox{Min: Vec3(2, -2, 4), Max: Vec3(4, 0, 6)})
+	item := hash.Insert(BoundingBox{Min: Vec3(1, 1, 1), Max: Vec3(2.5, 1.5, 1.5)}, "item")
+	c.Check(hash.Data(item), Equals, "item")
+	c.Check(hash.QueryCell(Pt3(0, 0, 0), nil), DeepEquals, []int{item})
+	c.Check(hash.QueryCell(Pt3(1, 0, 0), nil), DeepEquals, []int{item})
+	c.Check(hash.QueryCell(Pt3(0, 1, 0), nil), HasLen, 0)
+}
+
+func (s *SpatialHashTestSuite) TestTraverseRay(c *C) {
+	hash := NewSpatialHash(1)
+	var cells []Point3
+	var distances []float32
+	visit := func(cell Point3, distance float32) bool {
+		cells = append(cells, cell)
+		distances = append(distances, distance)
+		return true
+	}
+	// The cell entered exactly at maxDistance is visited.
+	hash.TraverseRay(NewRay(Vec3(0.5, 0.5, 0.5), Vec3(1, 0.5, 0)), 3, visit)
+	c.Check(cells, DeepEquals, []Point3{{0, 0, 0}, {1, 0, 0}, {1, 1, 0}, {2, 1, 0}, {3, 1, 0}, {3, 2, 0}})
+	c.Check(distances, DeepEquals, []float32{0, 0.5, 1, 1.5, 2.5, 3})
+
+	// Negative directions and stopping early.
+	cells = nil
+	hash.TraverseRay(NewRay(Vec3(0.5, 0.5, 0.5), Vec3(0, 0, -1)), 100, func(cell Point3, distance float32) bool {
+		cells = append(cells, cell)
+		return cell.Z > -2
+	})
+	c.Check(cells, DeepEquals, []Point3{{0, 0, 0}, {0, 0, -1}, {0, 0, -2}})
+
+	// A zero direction only visits the cell of the origin, even without a maximum distance.
+	cells = nil
+	hash.TraverseRay(NewRay(Vec3(2.5, -0.5, 0.5), Vec3(0, 0, 0)), Inf(1), func(cell Point3, distance float32) bool {
+		cells = append(cells, cell)
+		return len(cells) < 10
+	})
+	c.Check(cells, DeepEquals, []Point3{{2, -1, 0}})
+	var cells2 []Point
+	NewSpatialHash2(1).TraverseRay(Vec2(2.5, -0.5), Vec2(0, 0), Inf(1), func(cell Point, distance float32) bool {
+		cells2 = append(cells2, cell)
+		return len(cells2) < 10
+	})
+	c.Check(cells2, DeepEquals, []Point{{2, -1}})
+
+	// Every cell along a diagonal segment is adjacent to the previous one.
+	cells = nil
+	hash.TraverseSegment(NewSegment(Vec3(-3.2, 1.7, 0.1), Vec3(4.9, -2.6, 3.3)), func(cell Point3, distance float32) bool {
+		cells = append(cells, cell)
+		c.Check(distance >= 0 && distance <= 1, Equals, true)
+		return true
+	})
+	c.Check(cells[0], Equals, Pt3(-4, 1, 0))
+	c.Check(cells[len(cells)-1], Equals, Pt3(4, -3, 3))
+	for i := 1; i < len(cells); i++ {
+		c.Check(cells[i].ManhattanDistance(cells[i-1]), Equals, 1)
+	}
+	c.Check(cells, HasLen, 1+8+4+3)
+}
+
+func (s *SpatialHashTestSuite) TestSpatialHash2(c *C) {
+	hash := NewSpatialHash2(16)
+	c.Check(hash.Cell(Vec2(-1, 40)), Equals, Pt(-1, 2))
+	c.Check(hash.CellBounds(Pt(-1, 2)), Equals, Rectangle{-16, 32, 16, 16})
+	wall := hash.Insert(Rectangle{48, 0, 16, 64}, "wall")
+	crate := hash.Insert(Rectangle{20, 20, 4, 4}, "crate")
+	c.Check(hash.Len(), Equals, 2)
+	c.Check(hash.Bounds(wall), Equals, Rectangle{48, 0, 16, 64})
+	c.Check(hash.Query(Rect(0, 0, 30, 30), nil), DeepEquals, []int{crate})
+	c.Check(hash.QueryCell(Pt(3, 3), nil), DeepEquals, []int{wall})
+	hash.Update(crate, Rectangle{50, 50, 4, 4})
+	c.Check(sortedInts(hash.Query(Rect(49, 49, 2, 2), nil)), DeepEquals, []int{wall, crate})
+	c.Check(hash.Data(crate), Equals, "crate")
+
+	// Line of sight over a tile map, blocked by the wall.
+	var cells []Point
+	hash.TraverseSegment(Vec2(8, 8), Vec2(56, 40), func(cell Point, distance float32) bool {
+		cells = append(cells, cell)
+		return len(hash.QueryCell(cell, nil)) == 0
+	})
+	c.Check(cells, DeepEquals, []Point{{0, 0}, {1, 0}, {1, 1}, {2, 1}, {2, 2}, {3, 2}})
+	cells = nil
+	hash.TraverseRay(Vec2(8, 8), Vec2(-1, 0), 20, func(cell Point, distance float32) bool {
+		cells = append(cells, cell)
+		return true
+	})
+	c.Check(cells, DeepEquals, []Point{{0, 0}, {-1, 0}})
+
+	hash.Remove(wall)
+	c.Check(hash.QueryCell(Pt(3, 2), nil), HasLen, 0)
+	c.Check(func() { hash.Remove(wall) }, PanicMatches, "invalid item id")
+}
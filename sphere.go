@@ -13,3 +13,159 @@ func NewSphere(center Vector3, radius float32) *Sphere {
 func (s *Sphere) Overlaps(sphere *Sphere) bool {
 	return s.Center.Distance2(sphere.Center) < (s.Radius+sphere.Radius)*(s.Radius+sphere.Radius)
 }
+
+// Returns the smallest sphere containing all points using Welzl's algorithm, with the move-to-front
+// heuristic of Gärtner instead of a random permutation of the points. The sphere has a radius of 0 for no points.
+func NewMinimalSphere(points []Vector3) *Sphere {
+	if len(points) == 0 {
+		return &Sphere{}
+	}
+	list := make([]Vector3, len(points))
+	copy(list, points)
+	var support [4]Vector3
+	s := minimalSphere(list, len(list), support[:0])
+	return &s
+}
+
+// Returns the smallest sphere containing the first n points with the support points on its surface.
+// Points outside of the sphere are moved to the front of the list, so that they are checked first later on.
+func minimalSphere(points []Vector3, n int, support []Vector3) Sphere {
+	s := sphereFromSupport(support)
+	if len(support) == 4 {
+		return s
+	}
+	for i := 0; i < n; i++ {
+		p := points[i]
+		if s.encloses(p) {
+			continue
+		}
+		s = minimalSphere(points, i, append(support, p))
+		copy(points[1:i+1], points[:i])
+		points[0] = p
+	}
+	return s
+}
+
+// Returns the smallest sphere with all points on its surface, the empty sphere has a negative radius.
+// Degenerate sets of points, like three on a line, result in a sphere which contains them all.
+func sphereFromSupport(points []Vector3) Sphere {
+	const epsilon = 1e-6
+	switch len(points) {
+	case 0:
+		return Sphere{-1, Vector3{}}
+	case 1:
+		return Sphere{0, points[0]}
+	case 2:
+		return Sphere{points[0].Distance(points[1]) / 2, points[0].Lerp(points[1], 0.5)}
+	case 3:
+		a, b := points[0].Sub(points[2]), points[1].Sub(points[2])
+		axb := a.Cross(b)
+		denom := 2 * axb.Len2()
+		if denom <= epsilon*epsilon*a.Len2()*b.Len2() {
+			s := sphereFromSupport(points[:2])
+			return *s.ExtendByVec(points[2])
+		}
+		center := points[2].Add(b.Scale(a.Len2()).Sub(a.Scale(b.Len2())).Cross(axb).Scale(1 / denom))
+		return Sphere{farthestDistance(center, points), center}
+	}
+	a, b, c := points[1].Sub(points[0]), points[2].Sub(points[0]), points[3].Sub(points[0])
+	denom := 2 * a.Dot(b.Cross(c))
+	if Abs(denom) <= epsilon*a.Len()*b.Len()*c.Len() {
+		s := sphereFromSupport(points[:3])
+		return *s.ExtendByVec(points[3])
+	}
+	offset := b.Cross(c).Scale(a.Len2()).Add(c.Cross(a).Scale(b.Len2())).Add(a.Cross(b).Scale(c.Len2()))
+	center := points[0].Add(offset.Scale(1 / denom))
+	return Sphere{farthestDistance(center, points), center}
+}
+
+func farthestDistance(center Vector3, points []Vector3) float32 {
+	var distance float32
+	for _, p := range points {
+		distance = Max(distance, center.Distance(p))
+	}
+	return distance
+}
+
+// Returns whether the point is inside of the sphere, with some tolerance for rounding.
+func (s *Sphere) encloses(point Vector3) bool {
+	return s.Radius >= 0 && s.Center.Distance(point) <= s.Radius*(1+1e-5)
+}
+
+// Returns a sphere containing all points using Ritter's algorithm, which is fast but the sphere
+// may be up to about 20% larger than the minimal one. The sphere has a radius of 0 for no points.
+func NewApproximateSphere(points []Vector3) *Sphere {
+	if len(points) == 0 {
+		return &Sphere{}
+	}
+	// Start with the sphere around two points which are far apart.
+	farthest := func(from Vector3) Vector3 {
+		result := from
+		for _, p := range points {
+			if p.Distance2(from) > result.Distance2(from) {
+				result = p
+			}
+		}
+		return result
+	}
+	a := farthest(points[0])
+	b := farthest(a)
+	s := &Sphere{a.Distance(b) / 2, a.Lerp(b, 0.5)}
+	for _, p := range points {
+		s.ExtendByVec(p)
+	}
+	// Moving the center may leave points on the surface slightly outside due to rounding.
+	s.Radius = farthestDistance(s.Center, points)
+	return s
+}
+
+// Returns whether the point is inside of the sphere or on its surface.
+func (s *Sphere) ContainsVec(point Vector3) bool {
+	return s.Center.Distance2(point) <= s.Radius*s.Radius
+}
+
+// Returns the smallest axis aligned box around the sphere.
+func (s *Sphere) Bounds() BoundingBox {
+	r := Vec3(s.Radius, s.Radius, s.Radius)
+	return BoundingBox{Min: s.Center.Sub(r), Max: s.Center.Add(r)}
+}
+
+// Returns whether the sphere and the box have at least one point in common.
+func (s *Sphere) OverlapsBounds(box *BoundingBox) bool {
+	return IntersectSphereBounds(s, box)
+}
+
+// Returns whether the box is completely inside of the sphere.
+func (s *Sphere) ContainsBounds(box *BoundingBox) bool {
+	// The corner farthest from the center has to be inside.
+	farthest := s.Center.Sub(box.Min).Abs().Max(box.Max.Sub(s.Center).Abs())
+	return farthest.Len2() <= s.Radius*s.Radius
+}
+
+// Grows the sphere to the smallest sphere containing it and the other sphere.
+func (s *Sphere) Extend(sphere *Sphere) *Sphere {
+	distance := s.Center.Distance(sphere.Center)
+	switch {
+	case distance+sphere.Radius <= s.Radius:
+	case distance+s.Radius <= sphere.Radius:
+		*s = *sphere
+	default:
+		radius := (distance + s.Radius + sphere.Radius) / 2
+		s.Center = s.Center.Lerp(sphere.Center, (radius-s.Radius)/distance)
+		s.Radius = radius
+	}
+	return s
+}
+
+// Grows the sphere to the smallest sphere containing it and the point.
+func (s *Sphere) ExtendByVec(point Vector3) *Sphere {
+	distance := s.Center.Distance(point)
+	if distance <= s.Radius {
+		return s
+	}
+	radius := (distance + s.Radius) / 2
+	s.Center = s.Center.Lerp(point, (radius-s.Radius)/distance)
+	// Rounding may leave the point slightly outside.
+	s.Radius = Max(radius, s.Center.Distance(point))
+	return s
+}
@@ -1,6 +1,8 @@
 package math
 
 import (
+	"math/rand"
+
 	. "launchpad.net/gocheck"
 )
 
@@ -21,10 +23,95 @@ func (s *SphereTestSuite) SetUpTest(c *C) {
 	}
 }
 
-func (s *SphereTestSuite) Overlaps(c *C) {
-
+func (s *SphereTestSuite) TestOverlaps(c *C) {
 	for i := range s.containTestTable {
 		value := s.containTestTable[i]
 		c.Assert(value.Sphere.Overlaps(value.Sphere2), Equals, value.Expected)
 	}
 }
+
+func randomPoints(rnd *rand.Rand, n int) []Vector3 {
+	points := make([]Vector3, n)
+	for i := range points {
+		points[i] = Vec3(rnd.Float32()*10-3, rnd.Float32()*4+1, rnd.Float32()*6-2)
+	}
+	return points
+}
+
+// Returns how many points are on the surface of the sphere.
+func onSphere(sphere *Sphere, points []Vector3) int {
+	count := 0
+	for _, p := range points {
+		if Abs(sphere.Center.Distance(p)-sphere.Radius) <= sphere.Radius*1e-4 {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *SphereTestSuite) TestMinimalSphere(c *C) {
+	c.Check(*NewMinimalSphere(nil), Equals, Sphere{})
+	c.Check(*NewMinimalSphere([]Vector3{Vec3(1, 2, 3)}), Equals, Sphere{0, Vec3(1, 2, 3)})
+	c.Check(*NewMinimalSphere([]Vector3{Vec3(1, 2, 3), Vec3(1, 2, 3)}), Equals, Sphere{0, Vec3(1, 2, 3)})
+
+	corners := NewBoundingBox(Vec3(-1, -1, -1), Vec3(1, 1, 1)).Corners()
+	sphere := NewMinimalSphere(append(corners, Vec3(0, 0.5, 0)))
+	c.Check(sphere.Center.Len() < 0.0001, Equals, true)
+	c.Check(sphere.Radius, EqualsFloat32, Sqrt(3))
+
+	// Points on a line and in a plane.
+	sphere = NewMinimalSphere([]Vector3{Vec3(0, 0, 0), Vec3(3, 0, 0), Vec3(1, 0, 0), Vec3(-1, 0, 0)})
+	c.Check(*sphere, Equals, Sphere{2, Vec3(1, 0, 0)})
+	sphere = NewMinimalSphere([]Vector3{Vec3(1, 0, 0), Vec3(0, 1, 0), Vec3(-1, 0, 0), Vec3(0, -1, 0), Vec3(0.5, 0.5, 0)})
+	c.Check(sphere.Center.Len() < 0.0001, Equals, true)
+	c.Check(sphere.Radius, EqualsFloat32, float32(1))
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		points := randomPoints(rnd, 1+rnd.Intn(200))
+		sphere := NewMinimalSphere(points)
+		approximate := NewApproximateSphere(points)
+		for _, p := range points {
+			c.Check(sphere.Center.Distance(p) <= sphere.Radius*1.0001, Equals, true)
+			c.Check(approximate.Center.Distance(p) <= approximate.Radius, Equals, true)
+		}
+		c.Check(sphere.Radius <= approximate.Radius*1.0001, Equals, true)
+		if len(points) > 1 {
+			// A minimal sphere touches at least two points and can't be shrunk.
+			c.Check(onSphere(sphere, points) >= 2, Equals, true)
+		}
+	}
+}
+
+func (s *SphereTestSuite) TestApproximateSphere(c *C) {
+	c.Check(*NewApproximateSphere(nil), Equals, Sphere{})
+	sphere := NewApproximateSphere([]Vector3{Vec3(0, 0, 0), Vec3(4, 0, 0), Vec3(2, 1, 0)})
+	c.Check(*sphere, Equals, Sphere{2, Vec3(2, 0, 0)})
+}
+
+func (s *SphereTestSuite) TestExtend(c *C) {
+	sphere := NewSphere(Vec3(0, 0, 0), 1)
+	c.Check(*sphere.Extend(NewSphere(Vec3(0.5, 0, 0), 0.5)), Equals, Sphere{1, Vec3(0, 0, 0)})
+	c.Check(*sphere.Extend(NewSphere(Vec3(4, 0, 0), 1)), Equals, Sphere{3, Vec3(2, 0, 0)})
+	c.Check(*sphere.Extend(NewSphere(Vec3(2, 1, 0), 5)), Equals, Sphere{5, Vec3(2, 1, 0)})
+
+	sphere = NewSphere(Vec3(0, 0, 0), 1)
+	c.Check(*sphere.ExtendByVec(Vec3(0, 0.5, 0)), Equals, Sphere{1, Vec3(0, 0, 0)})
+	c.Check(*sphere.ExtendByVec(Vec3(0, 3, 0)), Equals, Sphere{2, Vec3(0, 1, 0)})
+	c.Check(sphere.ContainsVec(Vec3(0, -1, 0)), Equals, true)
+	c.Check(sphere.ContainsVec(Vec3(0, -1.1, 0)), Equals, false)
+}
+
+func (s *SphereTestSuite) TestBounds(c *C) {
+	sphere := NewSphere(Vec3(1, 2, 3), 2)
+	c.Check(sphere.Bounds(), Equals, BoundingBox{Min: Vec3(-1, 0, 1), Max: Vec3(3, 4, 5)})
+
+	c.Check(sphere.OverlapsBounds(NewBoundingBox(Vec3(2, 3, 4), Vec3(5, 5, 5))), Equals, true)
+	c.Check(sphere.OverlapsBounds(NewBoundingBox(Vec3(2.2, 3.2, 4.2), Vec3(5, 5, 5))), Equals, false)
+	c.Check(sphere.OverlapsBounds(NewBoundingBox(Vec3(-5, -5, -5), Vec3(5, 5, 5))), Equals, true)
+
+	c.Check(sphere.ContainsBounds(NewBoundingBox(Vec3(0, 1, 2), Vec3(2, 3, 4))), Equals, true)
+	c.Check(sphere.ContainsBounds(NewBoundingBox(Vec3(0, 1, 2), Vec3(2.2, 3.2, 4.2))), Equals, false)
+	c.Check(sphere.ContainsBounds(NewBoundingBox(Vec3(2.5, 2, 3), Vec3(2.9, 2.1, 3.1))), Equals, true)
+	c.Check(sphere.ContainsBounds(NewBoundingBox(Vec3(2.5, 2, 3), Vec3(3.1, 2.1, 3.1))), Equals, false)
+}
@@ -0,0 +1,173 @@
+package math
+
+import (
+	"sort"
+)
+
+// A change of the overlap of two items reported by SweepAndPrune.Update, A < B.
+type PairEvent struct {
+	A, B int
+	// True if the items started to overlap, false if they stopped overlapping.
+	Added bool
+}
+
+type sapEndpoint struct {
+	value float32
+	item  int
+	isMax bool
+}
+
+// Endpoints are ordered by value, minimums come first so that touching boxes overlap.
+func (e *sapEndpoint) less(o *sapEndpoint) bool {
+	return e.value < o.value || (e.value == o.value && !e.isMax && o.isMax)
+}
+
+// A broadphase which keeps the bounds of the items sorted along each axis and tracks the overlapping pairs.
+// The sorted order is updated with insertion sort, which is fast when the items move only a little between
+// updates. Unlike DynamicTree and SpatialHash the overlapping pairs are reported as events,
+// which suits scenes where most overlaps persist from frame to frame.
+// Items are identified by the id returned from Insert, see itemPool.
+type SweepAndPrune struct {
+	axes    [3][]sapEndpoint
+	pool    itemPool
+	bounds  []BoundingBox
+	pairs   map[[2]int]bool
+	pending []PairEvent
+}
+
+func NewSweepAndPrune() *SweepAndPrune {
+	return &SweepAndPrune{pairs: make(map[[2]int]bool)}
+}
+
+// The number of items
+func (s *SweepAndPrune) Len() int {
+	return s.pool.count
+}
+
+// Inserts an item with the bounds and returns its id. data can be used to associate an object with the item.
+// The overlaps of the item are reported by the next Update.
+func (s *SweepAndPrune) Insert(bounds BoundingBox, data interface{}) int {
+	item := s.pool.insert(data)
+	if item == len(s.bounds) {
+		s.bounds = append(s.bounds, BoundingBox{})
+	}
+	s.bounds[item] = bounds
+	// The endpoints are appended as if the item was right of all others and sorted into place by the next Update.
+	for axis := range s.axes {
+		s.axes[axis] = append(s.axes[axis],
+			sapEndpoint{bounds.Min.Get(axis), item, false}, sapEndpoint{bounds.Max.Get(axis), item, true})
+	}
+	return item
+}
+
+// Removes the item, see itemPool. The pairs of the item are reported as removed by the next Update.
+func (s *SweepAndPrune) Remove(item int) {
+	s.pool.remove(item)
+	for axis, endpoints := range s.axes {
+		n := 0
+		for _, e := range endpoints {
+			if e.item != item {
+				endpoints[n] = e
+				n++
+			}
+		}
+		s.axes[axis] = endpoints[:n]
+	}
+	for pair := range s.pairs {
+		if pair[0] == item || pair[1] == item {
+			delete(s.pairs, pair)
+			s.pending = append(s.pending, PairEvent{pair[0], pair[1], false})
+		}
+	}
+	s.bounds[item] = BoundingBox{}
+}
+
+// Sets the bounds of the item after it moved or changed its size. The changed overlaps are reported by the next Update.
+func (s *SweepAndPrune) SetBounds(item int, bounds BoundingBox) {
+	s.pool.check(item)
+	s.bounds[item] = bounds
+}
+
+// The bounds of the item.
+func (s *SweepAndPrune) Bounds(item int) BoundingBox {
+	s.pool.check(item)
+	return s.bounds[item]
+}
+
+// The data given when the item was inserted.
+func (s *SweepAndPrune) Data(item int) interface{} {
+	return s.pool.get(item)
+}
+
+// Returns whether the bounds of the items overlapped at the last Update.
+func (s *SweepAndPrune) Overlaps(a, b int) bool {
+	s.pool.check(a)
+	s.pool.check(b)
+	if a > b {
+		a, b = b, a
+	}
+	return s.pairs[[2]int{a, b}]
+}
+
+// Appends the pairs of items whose bounds overlapped at the last Update to result, ordered by A and then B.
+func (s *SweepAndPrune) Pairs(result [][2]int) [][2]int {
+	start := len(result)
+	for pair := range s.pairs {
+		result = append(result, pair)
+	}
+	pairs := result[start:]
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0] || (pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1])
+	})
+	return result
+}
+
+// Sorts the bounds of the items after changes by Insert, Remove and SetBounds and appends
+// the pairs which started or stopped overlapping since the last Update to result.
+// The events of removed items come first, the order of the other events is unspecified.
+func (s *SweepAndPrune) Update(result []PairEvent) []PairEvent {
+	result = append(result, s.pending...)
+	s.pending = s.pending[:0]
+	for axis := range s.axes {
+		endpoints := s.axes[axis]
+		for i := range endpoints {
+			e := &endpoints[i]
+			if e.isMax {
+				e.value = s.bounds[e.item].Max.Get(axis)
+			} else {
+				e.value = s.bounds[e.item].Min.Get(axis)
+			}
+		}
+		for i := 1; i < len(endpoints); i++ {
+			e := endpoints[i]
+			j := i - 1
+			for ; j >= 0 && e.less(&endpoints[j]); j-- {
+				// Only a minimum passing a maximum or the other way round can change the overlap.
+				if other := endpoints[j]; e.isMax != other.isMax {
+					result = s.updatePair(e.item, other.item, result)
+				}
+				endpoints[j+1] = endpoints[j]
+			}
+			endpoints[j+1] = e
+		}
+	}
+	return result
+}
+
+// Updates the pair with the overlap of the current bounds, which is the overlap after the sort is complete.
+func (s *SweepAndPrune) updatePair(a, b int, result []PairEvent) []PairEvent {
+	if a > b {
+		a, b = b, a
+	}
+	pair := [2]int{a, b}
+	overlaps := s.bounds[a].Overlaps(&s.bounds[b])
+	if overlaps != s.pairs[pair] {
+		if overlaps {
+			s.pairs[pair] = true
+		} else {
+			delete(s.pairs, pair)
+		}
+		result = append(result, PairEvent{a, b, overlaps})
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package math
+
+import (
+	"math/rand"
+
+	. "launchpad.net/gocheck"
+)
+
+type SweepAndPruneTestSuite struct{}
+
+var _ = Suite(&SweepAndPruneTestSuite{})
+
+func bruteForcePairs(sap *SweepAndPrune, items map[int]bool) [][2]int {
+	var pairs [][2]int
+	for a := range sap.bounds {
+		for b := a + 1; b < len(sap.bounds); b++ {
+			if items[a] && items[b] && sap.bounds[a].Overlaps(&sap.bounds[b]) {
+				pairs = append(pairs, [2]int{a, b})
+			}
+		}
+	}
+	return pairs
+}
+
+func (s *SweepAndPruneTestSuite) TestUpdate(c *C) {
+	rnd := rand.New(rand.NewSource(1))
+	sap := NewSweepAndPrune()
+	items := make(map[int]bool)
+	for i, box := range randomBounds(rnd, 200, 8) {
+		items[sap.Insert(box, i)] = true
+	}
+	c.Check(sap.Len(), Equals, 200)
+	c.Check(sap.Data(5), Equals, 5)
+
+	// The events applied to the pairs of the last frame must give the current pairs.
+	pairs := make(map[[2]int]bool)
+	for frame := 0; frame < 50; frame++ {
+		for _, event := range sap.Update(nil) {
+			c.Assert(event.A < event.B, Equals, true)
+			c.Assert(pairs[[2]int{event.A, event.B}], Equals, !event.Added)
+			if event.Added {
+				pairs[[2]int{event.A, event.B}] = true
+			} else {
+				delete(pairs, [2]int{event.A, event.B})
+			}
+		}
+		expected := bruteForcePairs(sap, items)
+		c.Assert(len(pairs), Equals, len(expected))
+		c.Assert(sap.Pairs(nil), DeepEquals, expected)
+		for _, pair := range expected {
+			c.Assert(sap.Overlaps(pair[1], pair[0]), Equals, true)
+		}
+
+		for item := range items {
+			d := Vec3(rnd.Float32()-0.5, rnd.Float32()-0.5, rnd.Float32()-0.5)
+			box := sap.Bounds(item)
+			sap.SetBounds(item, BoundingBox{Min: box.Min.Add(d), Max: box.Max.Add(d)})
+		}
+		if frame%5 == 0 {
+			for item := range items {
+				sap.Remove(item)
+				delete(items, item)
+				break
+			}
+			for _, box := range randomBounds(rnd, 2, 8) {
+				items[sap.Insert(box, nil)] = true
+			}
+		}
+	}
+	for axis := range sap.axes {
+		for i := 1; i < len(sap.axes[axis]); i++ {
+			c.Check(sap.axes[axis][i].less(&sap.axes[axis][i-1]), Equals, false)
+		}
+	}
+}
+
+func (s *SweepAndPruneTestSuite) TestEvents(c *C) {
+	sap := NewSweepAndPrune()
+	a := sap.Insert(BoundingBox{Min: Vec3(0, 0, 0), Max: Vec3(1, 1, 1)}, nil)
+	b := sap.Insert(BoundingBox{Min: Vec3(1, 0, 0), Max: Vec3(2, 1, 1)}, nil)
+	d := sap.Insert(BoundingBox{Min: Vec3(5, 0, 0), Max: Vec3(6, 1, 1)}, nil)
+	// Touching boxes overlap.
+	c.Check(sap.Update(nil), DeepEquals, []PairEvent{{a, b, true}})
+	c.Check(sap.Update(nil), HasLen, 0)
+
+	sap.SetBounds(d, BoundingBox{Min: Vec3(0.5, 0.5, 0.5), Max: Vec3(1.5, 1.5, 1.5)})
+	sap.SetBounds(b, BoundingBox{Min: Vec3(3, 0, 0), Max: Vec3(4, 1, 1)})
+	events := sap.Update(nil)
+	c.Check(events, HasLen, 2)
+	for _, event := range events {
+		c.Check(event == PairEvent{a, b, false} || event == PairEvent{a, d, true}, Equals, true)
+	}
+	c.Check(sap.Pairs(nil), DeepEquals, [][2]int{{a, d}})
+
+	sap.Remove(a)
+	c.Check(sap.Len(), Equals, 2)
+	c.Check(func() { sap.Remove(a) }, PanicMatches, "invalid item id")
+	c.Check(func() { sap.SetBounds(a, BoundingBox{}) }, PanicMatches, "invalid item id")
+	c.Check(func() { sap.Overlaps(a, d) }, PanicMatches, "invalid item id")
+	c.Check(sap.Len(), Equals, 2)
+	c.Check(sap.Update(nil), DeepEquals, []PairEvent{{a, d, false}})
+	c.Check(sap.Pairs(nil), HasLen, 0)
+}
@@ -0,0 +1,767 @@
+// Code generated by swizzlegen.go; DO NOT EDIT.
+
+package math
+
+// Swizzles of Vector2
+
+func (vec Vector2) XX() Vector2   { return Vector2{vec.X, vec.X} }
+func (vec Vector2) XY() Vector2   { return Vector2{vec.X, vec.Y} }
+func (vec Vector2) YX() Vector2   { return Vector2{vec.Y, vec.X} }
+func (vec Vector2) YY() Vector2   { return Vector2{vec.Y, vec.Y} }
+func (vec Vector2) XXX() Vector3  { return Vector3{vec.X, vec.X, vec.X} }
+func (vec Vector2) XXY() Vector3  { return Vector3{vec.X, vec.X, vec.Y} }
+func (vec Vector2) XYX() Vector3  { return Vector3{vec.X, vec.Y, vec.X} }
+func (vec Vector2) XYY() Vector3  { return Vector3{vec.X, vec.Y, vec.Y} }
+func (vec Vector2) YXX() Vector3  { return Vector3{vec.Y, vec.X, vec.X} }
+func (vec Vector2) YXY() Vector3  { return Vector3{vec.Y, vec.X, vec.Y} }
+func (vec Vector2) YYX() Vector3  { return Vector3{vec.Y, vec.Y, vec.X} }
+func (vec Vector2) YYY() Vector3  { return Vector3{vec.Y, vec.Y, vec.Y} }
+func (vec Vector2) XXXX() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.X} }
+func (vec Vector2) XXXY() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.Y} }
+func (vec Vector2) XXYX() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.X} }
+func (vec Vector2) XXYY() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.Y} }
+func (vec Vector2) XYXX() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.X} }
+func (vec Vector2) XYXY() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.Y} }
+func (vec Vector2) XYYX() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.X} }
+func (vec Vector2) XYYY() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.Y} }
+func (vec Vector2) YXXX() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.X} }
+func (vec Vector2) YXXY() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.Y} }
+func (vec Vector2) YXYX() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.X} }
+func (vec Vector2) YXYY() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.Y} }
+func (vec Vector2) YYXX() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.X} }
+func (vec Vector2) YYXY() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.Y} }
+func (vec Vector2) YYYX() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.X} }
+func (vec Vector2) YYYY() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.Y} }
+func (vec Vector2) X0() Vector2   { return Vector2{vec.X, 0} }
+func (vec Vector2) X1() Vector2   { return Vector2{vec.X, 1} }
+func (vec Vector2) Y0() Vector2   { return Vector2{vec.Y, 0} }
+func (vec Vector2) Y1() Vector2   { return Vector2{vec.Y, 1} }
+func (vec Vector2) XX0() Vector3  { return Vector3{vec.X, vec.X, 0} }
+func (vec Vector2) XX1() Vector3  { return Vector3{vec.X, vec.X, 1} }
+func (vec Vector2) XY0() Vector3  { return Vector3{vec.X, vec.Y, 0} }
+func (vec Vector2) XY1() Vector3  { return Vector3{vec.X, vec.Y, 1} }
+func (vec Vector2) YX0() Vector3  { return Vector3{vec.Y, vec.X, 0} }
+func (vec Vector2) YX1() Vector3  { return Vector3{vec.Y, vec.X, 1} }
+func (vec Vector2) YY0() Vector3  { return Vector3{vec.Y, vec.Y, 0} }
+func (vec Vector2) YY1() Vector3  { return Vector3{vec.Y, vec.Y, 1} }
+func (vec Vector2) XXX0() Vector4 { return Vector4{vec.X, vec.X, vec.X, 0} }
+func (vec Vector2) XXX1() Vector4 { return Vector4{vec.X, vec.X, vec.X, 1} }
+func (vec Vector2) XXY0() Vector4 { return Vector4{vec.X, vec.X, vec.Y, 0} }
+func (vec Vector2) XXY1() Vector4 { return Vector4{vec.X, vec.X, vec.Y, 1} }
+func (vec Vector2) XYX0() Vector4 { return Vector4{vec.X, vec.Y, vec.X, 0} }
+func (vec Vector2) XYX1() Vector4 { return Vector4{vec.X, vec.Y, vec.X, 1} }
+func (vec Vector2) XYY0() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, 0} }
+func (vec Vector2) XYY1() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, 1} }
+func (vec Vector2) YXX0() Vector4 { return Vector4{vec.Y, vec.X, vec.X, 0} }
+func (vec Vector2) YXX1() Vector4 { return Vector4{vec.Y, vec.X, vec.X, 1} }
+func (vec Vector2) YXY0() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, 0} }
+func (vec Vector2) YXY1() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, 1} }
+func (vec Vector2) YYX0() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, 0} }
+func (vec Vector2) YYX1() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, 1} }
+func (vec Vector2) YYY0() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, 0} }
+func (vec Vector2) YYY1() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, 1} }
+
+// Swizzles of Vector3
+
+func (vec Vector3) XX() Vector2   { return Vector2{vec.X, vec.X} }
+func (vec Vector3) XY() Vector2   { return Vector2{vec.X, vec.Y} }
+func (vec Vector3) XZ() Vector2   { return Vector2{vec.X, vec.Z} }
+func (vec Vector3) YX() Vector2   { return Vector2{vec.Y, vec.X} }
+func (vec Vector3) YY() Vector2   { return Vector2{vec.Y, vec.Y} }
+func (vec Vector3) YZ() Vector2   { return Vector2{vec.Y, vec.Z} }
+func (vec Vector3) ZX() Vector2   { return Vector2{vec.Z, vec.X} }
+func (vec Vector3) ZY() Vector2   { return Vector2{vec.Z, vec.Y} }
+func (vec Vector3) ZZ() Vector2   { return Vector2{vec.Z, vec.Z} }
+func (vec Vector3) XXX() Vector3  { return Vector3{vec.X, vec.X, vec.X} }
+func (vec Vector3) XXY() Vector3  { return Vector3{vec.X, vec.X, vec.Y} }
+func (vec Vector3) XXZ() Vector3  { return Vector3{vec.X, vec.X, vec.Z} }
+func (vec Vector3) XYX() Vector3  { return Vector3{vec.X, vec.Y, vec.X} }
+func (vec Vector3) XYY() Vector3  { return Vector3{vec.X, vec.Y, vec.Y} }
+func (vec Vector3) XYZ() Vector3  { return Vector3{vec.X, vec.Y, vec.Z} }
+func (vec Vector3) XZX() Vector3  { return Vector3{vec.X, vec.Z, vec.X} }
+func (vec Vector3) XZY() Vector3  { return Vector3{vec.X, vec.Z, vec.Y} }
+func (vec Vector3) XZZ() Vector3  { return Vector3{vec.X, vec.Z, vec.Z} }
+func (vec Vector3) YXX() Vector3  { return Vector3{vec.Y, vec.X, vec.X} }
+func (vec Vector3) YXY() Vector3  { return Vector3{vec.Y, vec.X, vec.Y} }
+func (vec Vector3) YXZ() Vector3  { return Vector3{vec.Y, vec.X, vec.Z} }
+func (vec Vector3) YYX() Vector3  { return Vector3{vec.Y, vec.Y, vec.X} }
+func (vec Vector3) YYY() Vector3  { return Vector3{vec.Y, vec.Y, vec.Y} }
+func (vec Vector3) YYZ() Vector3  { return Vector3{vec.Y, vec.Y, vec.Z} }
+func (vec Vector3) YZX() Vector3  { return Vector3{vec.Y, vec.Z, vec.X} }
+func (vec Vector3) YZY() Vector3  { return Vector3{vec.Y, vec.Z, vec.Y} }
+func (vec Vector3) YZZ() Vector3  { return Vector3{vec.Y, vec.Z, vec.Z} }
+func (vec Vector3) ZXX() Vector3  { return Vector3{vec.Z, vec.X, vec.X} }
+func (vec Vector3) ZXY() Vector3  { return Vector3{vec.Z, vec.X, vec.Y} }
+func (vec Vector3) ZXZ() Vector3  { return Vector3{vec.Z, vec.X, vec.Z} }
+func (vec Vector3) ZYX() Vector3  { return Vector3{vec.Z, vec.Y, vec.X} }
+func (vec Vector3) ZYY() Vector3  { return Vector3{vec.Z, vec.Y, vec.Y} }
+func (vec Vector3) ZYZ() Vector3  { return Vector3{vec.Z, vec.Y, vec.Z} }
+func (vec Vector3) ZZX() Vector3  { return Vector3{vec.Z, vec.Z, vec.X} }
+func (vec Vector3) ZZY() Vector3  { return Vector3{vec.Z, vec.Z, vec.Y} }
+func (vec Vector3) ZZZ() Vector3  { return Vector3{vec.Z, vec.Z, vec.Z} }
+func (vec Vector3) XXXX() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.X} }
+func (vec Vector3) XXXY() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.Y} }
+func (vec Vector3) XXXZ() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.Z} }
+func (vec Vector3) XXYX() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.X} }
+func (vec Vector3) XXYY() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.Y} }
+func (vec Vector3) XXYZ() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.Z} }
+func (vec Vector3) XXZX() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.X} }
+func (vec Vector3) XXZY() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.Y} }
+func (vec Vector3) XXZZ() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.Z} }
+func (vec Vector3) XYXX() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.X} }
+func (vec Vector3) XYXY() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.Y} }
+func (vec Vector3) XYXZ() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.Z} }
+func (vec Vector3) XYYX() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.X} }
+func (vec Vector3) XYYY() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.Y} }
+func (vec Vector3) XYYZ() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.Z} }
+func (vec Vector3) XYZX() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.X} }
+func (vec Vector3) XYZY() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.Y} }
+func (vec Vector3) XYZZ() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.Z} }
+func (vec Vector3) XZXX() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.X} }
+func (vec Vector3) XZXY() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.Y} }
+func (vec Vector3) XZXZ() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.Z} }
+func (vec Vector3) XZYX() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.X} }
+func (vec Vector3) XZYY() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.Y} }
+func (vec Vector3) XZYZ() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.Z} }
+func (vec Vector3) XZZX() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.X} }
+func (vec Vector3) XZZY() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.Y} }
+func (vec Vector3) XZZZ() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.Z} }
+func (vec Vector3) YXXX() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.X} }
+func (vec Vector3) YXXY() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.Y} }
+func (vec Vector3) YXXZ() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.Z} }
+func (vec Vector3) YXYX() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.X} }
+func (vec Vector3) YXYY() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.Y} }
+func (vec Vector3) YXYZ() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.Z} }
+func (vec Vector3) YXZX() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.X} }
+func (vec Vector3) YXZY() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.Y} }
+func (vec Vector3) YXZZ() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.Z} }
+func (vec Vector3) YYXX() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.X} }
+func (vec Vector3) YYXY() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.Y} }
+func (vec Vector3) YYXZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.Z} }
+func (vec Vector3) YYYX() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.X} }
+func (vec Vector3) YYYY() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.Y} }
+func (vec Vector3) YYYZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.Z} }
+func (vec Vector3) YYZX() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.X} }
+func (vec Vector3) YYZY() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.Y} }
+func (vec Vector3) YYZZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.Z} }
+func (vec Vector3) YZXX() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.X} }
+func (vec Vector3) YZXY() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.Y} }
+func (vec Vector3) YZXZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.Z} }
+func (vec Vector3) YZYX() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.X} }
+func (vec Vector3) YZYY() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.Y} }
+func (vec Vector3) YZYZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.Z} }
+func (vec Vector3) YZZX() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.X} }
+func (vec Vector3) YZZY() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.Y} }
+func (vec Vector3) YZZZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.Z} }
+func (vec Vector3) ZXXX() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.X} }
+func (vec Vector3) ZXXY() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.Y} }
+func (vec Vector3) ZXXZ() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.Z} }
+func (vec Vector3) ZXYX() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.X} }
+func (vec Vector3) ZXYY() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.Y} }
+func (vec Vector3) ZXYZ() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.Z} }
+func (vec Vector3) ZXZX() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.X} }
+func (vec Vector3) ZXZY() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.Y} }
+func (vec Vector3) ZXZZ() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.Z} }
+func (vec Vector3) ZYXX() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.X} }
+func (vec Vector3) ZYXY() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.Y} }
+func (vec Vector3) ZYXZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.Z} }
+func (vec Vector3) ZYYX() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.X} }
+func (vec Vector3) ZYYY() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.Y} }
+func (vec Vector3) ZYYZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.Z} }
+func (vec Vector3) ZYZX() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.X} }
+func (vec Vector3) ZYZY() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.Y} }
+func (vec Vector3) ZYZZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.Z} }
+func (vec Vector3) ZZXX() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.X} }
+func (vec Vector3) ZZXY() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.Y} }
+func (vec Vector3) ZZXZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.Z} }
+func (vec Vector3) ZZYX() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.X} }
+func (vec Vector3) ZZYY() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.Y} }
+func (vec Vector3) ZZYZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.Z} }
+func (vec Vector3) ZZZX() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.X} }
+func (vec Vector3) ZZZY() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.Y} }
+func (vec Vector3) ZZZZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.Z} }
+func (vec Vector3) X0() Vector2   { return Vector2{vec.X, 0} }
+func (vec Vector3) X1() Vector2   { return Vector2{vec.X, 1} }
+func (vec Vector3) Y0() Vector2   { return Vector2{vec.Y, 0} }
+func (vec Vector3) Y1() Vector2   { return Vector2{vec.Y, 1} }
+func (vec Vector3) Z0() Vector2   { return Vector2{vec.Z, 0} }
+func (vec Vector3) Z1() Vector2   { return Vector2{vec.Z, 1} }
+func (vec Vector3) XX0() Vector3  { return Vector3{vec.X, vec.X, 0} }
+func (vec Vector3) XX1() Vector3  { return Vector3{vec.X, vec.X, 1} }
+func (vec Vector3) XY0() Vector3  { return Vector3{vec.X, vec.Y, 0} }
+func (vec Vector3) XY1() Vector3  { return Vector3{vec.X, vec.Y, 1} }
+func (vec Vector3) XZ0() Vector3  { return Vector3{vec.X, vec.Z, 0} }
+func (vec Vector3) XZ1() Vector3  { return Vector3{vec.X, vec.Z, 1} }
+func (vec Vector3) YX0() Vector3  { return Vector3{vec.Y, vec.X, 0} }
+func (vec Vector3) YX1() Vector3  { return Vector3{vec.Y, vec.X, 1} }
+func (vec Vector3) YY0() Vector3  { return Vector3{vec.Y, vec.Y, 0} }
+func (vec Vector3) YY1() Vector3  { return Vector3{vec.Y, vec.Y, 1} }
+func (vec Vector3) YZ0() Vector3  { return Vector3{vec.Y, vec.Z, 0} }
+func (vec Vector3) YZ1() Vector3  { return Vector3{vec.Y, vec.Z, 1} }
+func (vec Vector3) ZX0() Vector3  { return Vector3{vec.Z, vec.X, 0} }
+func (vec Vector3) ZX1() Vector3  { return Vector3{vec.Z, vec.X, 1} }
+func (vec Vector3) ZY0() Vector3  { return Vector3{vec.Z, vec.Y, 0} }
+func (vec Vector3) ZY1() Vector3  { return Vector3{vec.Z, vec.Y, 1} }
+func (vec Vector3) ZZ0() Vector3  { return Vector3{vec.Z, vec.Z, 0} }
+func (vec Vector3) ZZ1() Vector3  { return Vector3{vec.Z, vec.Z, 1} }
+func (vec Vector3) XXX0() Vector4 { return Vector4{vec.X, vec.X, vec.X, 0} }
+func (vec Vector3) XXX1() Vector4 { return Vector4{vec.X, vec.X, vec.X, 1} }
+func (vec Vector3) XXY0() Vector4 { return Vector4{vec.X, vec.X, vec.Y, 0} }
+func (vec Vector3) XXY1() Vector4 { return Vector4{vec.X, vec.X, vec.Y, 1} }
+func (vec Vector3) XXZ0() Vector4 { return Vector4{vec.X, vec.X, vec.Z, 0} }
+func (vec Vector3) XXZ1() Vector4 { return Vector4{vec.X, vec.X, vec.Z, 1} }
+func (vec Vector3) XYX0() Vector4 { return Vector4{vec.X, vec.Y, vec.X, 0} }
+func (vec Vector3) XYX1() Vector4 { return Vector4{vec.X, vec.Y, vec.X, 1} }
+func (vec Vector3) XYY0() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, 0} }
+func (vec Vector3) XYY1() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, 1} }
+func (vec Vector3) XYZ0() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, 0} }
+func (vec Vector3) XYZ1() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, 1} }
+func (vec Vector3) XZX0() Vector4 { return Vector4{vec.X, vec.Z, vec.X, 0} }
+func (vec Vector3) XZX1() Vector4 { return Vector4{vec.X, vec.Z, vec.X, 1} }
+func (vec Vector3) XZY0() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, 0} }
+func (vec Vector3) XZY1() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, 1} }
+func (vec Vector3) XZZ0() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, 0} }
+func (vec Vector3) XZZ1() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, 1} }
+func (vec Vector3) YXX0() Vector4 { return Vector4{vec.Y, vec.X, vec.X, 0} }
+func (vec Vector3) YXX1() Vector4 { return Vector4{vec.Y, vec.X, vec.X, 1} }
+func (vec Vector3) YXY0() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, 0} }
+func (vec Vector3) YXY1() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, 1} }
+func (vec Vector3) YXZ0() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, 0} }
+func (vec Vector3) YXZ1() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, 1} }
+func (vec Vector3) YYX0() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, 0} }
+func (vec Vector3) YYX1() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, 1} }
+func (vec Vector3) YYY0() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, 0} }
+func (vec Vector3) YYY1() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, 1} }
+func (vec Vector3) YYZ0() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, 0} }
+func (vec Vector3) YYZ1() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, 1} }
+func (vec Vector3) YZX0() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, 0} }
+func (vec Vector3) YZX1() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, 1} }
+func (vec Vector3) YZY0() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, 0} }
+func (vec Vector3) YZY1() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, 1} }
+func (vec Vector3) YZZ0() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, 0} }
+func (vec Vector3) YZZ1() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, 1} }
+func (vec Vector3) ZXX0() Vector4 { return Vector4{vec.Z, vec.X, vec.X, 0} }
+func (vec Vector3) ZXX1() Vector4 { return Vector4{vec.Z, vec.X, vec.X, 1} }
+func (vec Vector3) ZXY0() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, 0} }
+func (vec Vector3) ZXY1() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, 1} }
+func (vec Vector3) ZXZ0() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, 0} }
+func (vec Vector3) ZXZ1() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, 1} }
+func (vec Vector3) ZYX0() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, 0} }
+func (vec Vector3) ZYX1() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, 1} }
+func (vec Vector3) ZYY0() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, 0} }
+func (vec Vector3) ZYY1() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, 1} }
+func (vec Vector3) ZYZ0() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, 0} }
+func (vec Vector3) ZYZ1() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, 1} }
+func (vec Vector3) ZZX0() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, 0} }
+func (vec Vector3) ZZX1() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, 1} }
+func (vec Vector3) ZZY0() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, 0} }
+func (vec Vector3) ZZY1() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, 1} }
+func (vec Vector3) ZZZ0() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, 0} }
+func (vec Vector3) ZZZ1() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, 1} }
+
+// Swizzles of Vector4
+
+func (vec Vector4) XX() Vector2   { return Vector2{vec.X, vec.X} }
+func (vec Vector4) XY() Vector2   { return Vector2{vec.X, vec.Y} }
+func (vec Vector4) XZ() Vector2   { return Vector2{vec.X, vec.Z} }
+func (vec Vector4) XW() Vector2   { return Vector2{vec.X, vec.W} }
+func (vec Vector4) YX() Vector2   { return Vector2{vec.Y, vec.X} }
+func (vec Vector4) YY() Vector2   { return Vector2{vec.Y, vec.Y} }
+func (vec Vector4) YZ() Vector2   { return Vector2{vec.Y, vec.Z} }
+func (vec Vector4) YW() Vector2   { return Vector2{vec.Y, vec.W} }
+func (vec Vector4) ZX() Vector2   { return Vector2{vec.Z, vec.X} }
+func (vec Vector4) ZY() Vector2   { return Vector2{vec.Z, vec.Y} }
+func (vec Vector4) ZZ() Vector2   { return Vector2{vec.Z, vec.Z} }
+func (vec Vector4) ZW() Vector2   { return Vector2{vec.Z, vec.W} }
+func (vec Vector4) WX() Vector2   { return Vector2{vec.W, vec.X} }
+func (vec Vector4) WY() Vector2   { return Vector2{vec.W, vec.Y} }
+func (vec Vector4) WZ() Vector2   { return Vector2{vec.W, vec.Z} }
+func (vec Vector4) WW() Vector2   { return Vector2{vec.W, vec.W} }
+func (vec Vector4) XXX() Vector3  { return Vector3{vec.X, vec.X, vec.X} }
+func (vec Vector4) XXY() Vector3  { return Vector3{vec.X, vec.X, vec.Y} }
+func (vec Vector4) XXZ() Vector3  { return Vector3{vec.X, vec.X, vec.Z} }
+func (vec Vector4) XXW() Vector3  { return Vector3{vec.X, vec.X, vec.W} }
+func (vec Vector4) XYX() Vector3  { return Vector3{vec.X, vec.Y, vec.X} }
+func (vec Vector4) XYY() Vector3  { return Vector3{vec.X, vec.Y, vec.Y} }
+func (vec Vector4) XYZ() Vector3  { return Vector3{vec.X, vec.Y, vec.Z} }
+func (vec Vector4) XYW() Vector3  { return Vector3{vec.X, vec.Y, vec.W} }
+func (vec Vector4) XZX() Vector3  { return Vector3{vec.X, vec.Z, vec.X} }
+func (vec Vector4) XZY() Vector3  { return Vector3{vec.X, vec.Z, vec.Y} }
+func (vec Vector4) XZZ() Vector3  { return Vector3{vec.X, vec.Z, vec.Z} }
+func (vec Vector4) XZW() Vector3  { return Vector3{vec.X, vec.Z, vec.W} }
+func (vec Vector4) XWX() Vector3  { return Vector3{vec.X, vec.W, vec.X} }
+func (vec Vector4) XWY() Vector3  { return Vector3{vec.X, vec.W, vec.Y} }
+func (vec Vector4) XWZ() Vector3  { return Vector3{vec.X, vec.W, vec.Z} }
+func (vec Vector4) XWW() Vector3  { return Vector3{vec.X, vec.W, vec.W} }
+func (vec Vector4) YXX() Vector3  { return Vector3{vec.Y, vec.X, vec.X} }
+func (vec Vector4) YXY() Vector3  { return Vector3{vec.Y, vec.X, vec.Y} }
+func (vec Vector4) YXZ() Vector3  { return Vector3{vec.Y, vec.X, vec.Z} }
+func (vec Vector4) YXW() Vector3  { return Vector3{vec.Y, vec.X, vec.W} }
+func (vec Vector4) YYX() Vector3  { return Vector3{vec.Y, vec.Y, vec.X} }
+func (vec Vector4) YYY() Vector3  { return Vector3{vec.Y, vec.Y, vec.Y} }
+func (vec Vector4) YYZ() Vector3  { return Vector3{vec.Y, vec.Y, vec.Z} }
+func (vec Vector4) YYW() Vector3  { return Vector3{vec.Y, vec.Y, vec.W} }
+func (vec Vector4) YZX() Vector3  { return Vector3{vec.Y, vec.Z, vec.X} }
+func (vec Vector4) YZY() Vector3  { return Vector3{vec.Y, vec.Z, vec.Y} }
+func (vec Vector4) YZZ() Vector3  { return Vector3{vec.Y, vec.Z, vec.Z} }
+func (vec Vector4) YZW() Vector3  { return Vector3{vec.Y, vec.Z, vec.W} }
+func (vec Vector4) YWX() Vector3  { return Vector3{vec.Y, vec.W, vec.X} }
+func (vec Vector4) YWY() Vector3  { return Vector3{vec.Y, vec.W, vec.Y} }
+func (vec Vector4) YWZ() Vector3  { return Vector3{vec.Y, vec.W, vec.Z} }
+func (vec Vector4) YWW() Vector3  { return Vector3{vec.Y, vec.W, vec.W} }
+func (vec Vector4) ZXX() Vector3  { return Vector3{vec.Z, vec.X, vec.X} }
+func (vec Vector4) ZXY() Vector3  { return Vector3{vec.Z, vec.X, vec.Y} }
+func (vec Vector4) ZXZ() Vector3  { return Vector3{vec.Z, vec.X, vec.Z} }
+func (vec Vector4) ZXW() Vector3  { return Vector3{vec.Z, vec.X, vec.W} }
+func (vec Vector4) ZYX() Vector3  { return Vector3{vec.Z, vec.Y, vec.X} }
+func (vec Vector4) ZYY() Vector3  { return Vector3{vec.Z, vec.Y, vec.Y} }
+func (vec Vector4) ZYZ() Vector3  { return Vector3{vec.Z, vec.Y, vec.Z} }
+func (vec Vector4) ZYW() Vector3  { return Vector3{vec.Z, vec.Y, vec.W} }
+func (vec Vector4) ZZX() Vector3  { return Vector3{vec.Z, vec.Z, vec.X} }
+func (vec Vector4) ZZY() Vector3  { return Vector3{vec.Z, vec.Z, vec.Y} }
+func (vec Vector4) ZZZ() Vector3  { return Vector3{vec.Z, vec.Z, vec.Z} }
+func (vec Vector4) ZZW() Vector3  { return Vector3{vec.Z, vec.Z, vec.W} }
+func (vec Vector4) ZWX() Vector3  { return Vector3{vec.Z, vec.W, vec.X} }
+func (vec Vector4) ZWY() Vector3  { return Vector3{vec.Z, vec.W, vec.Y} }
+func (vec Vector4) ZWZ() Vector3  { return Vector3{vec.Z, vec.W, vec.Z} }
+func (vec Vector4) ZWW() Vector3  { return Vector3{vec.Z, vec.W, vec.W} }
+func (vec Vector4) WXX() Vector3  { return Vector3{vec.W, vec.X, vec.X} }
+func (vec Vector4) WXY() Vector3  { return Vector3{vec.W, vec.X, vec.Y} }
+func (vec Vector4) WXZ() Vector3  { return Vector3{vec.W, vec.X, vec.Z} }
+func (vec Vector4) WXW() Vector3  { return Vector3{vec.W, vec.X, vec.W} }
+func (vec Vector4) WYX() Vector3  { return Vector3{vec.W, vec.Y, vec.X} }
+func (vec Vector4) WYY() Vector3  { return Vector3{vec.W, vec.Y, vec.Y} }
+func (vec Vector4) WYZ() Vector3  { return Vector3{vec.W, vec.Y, vec.Z} }
+func (vec Vector4) WYW() Vector3  { return Vector3{vec.W, vec.Y, vec.W} }
+func (vec Vector4) WZX() Vector3  { return Vector3{vec.W, vec.Z, vec.X} }
+func (vec Vector4) WZY() Vector3  { return Vector3{vec.W, vec.Z, vec.Y} }
+func (vec Vector4) WZZ() Vector3  { return Vector3{vec.W, vec.Z, vec.Z} }
+func (vec Vector4) WZW() Vector3  { return Vector3{vec.W, vec.Z, vec.W} }
+func (vec Vector4) WWX() Vector3  { return Vector3{vec.W, vec.W, vec.X} }
+func (vec Vector4) WWY() Vector3  { return Vector3{vec.W, vec.W, vec.Y} }
+func (vec Vector4) WWZ() Vector3  { return Vector3{vec.W, vec.W, vec.Z} }
+func (vec Vector4) WWW() Vector3  { return Vector3{vec.W, vec.W, vec.W} }
+func (vec Vector4) XXXX() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.X} }
+func (vec Vector4) XXXY() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.Y} }
+func (vec Vector4) XXXZ() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.Z} }
+func (vec Vector4) XXXW() Vector4 { return Vector4{vec.X, vec.X, vec.X, vec.W} }
+func (vec Vector4) XXYX() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.X} }
+func (vec Vector4) XXYY() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.Y} }
+func (vec Vector4) XXYZ() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.Z} }
+func (vec Vector4) XXYW() Vector4 { return Vector4{vec.X, vec.X, vec.Y, vec.W} }
+func (vec Vector4) XXZX() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.X} }
+func (vec Vector4) XXZY() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.Y} }
+func (vec Vector4) XXZZ() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.Z} }
+func (vec Vector4) XXZW() Vector4 { return Vector4{vec.X, vec.X, vec.Z, vec.W} }
+func (vec Vector4) XXWX() Vector4 { return Vector4{vec.X, vec.X, vec.W, vec.X} }
+func (vec Vector4) XXWY() Vector4 { return Vector4{vec.X, vec.X, vec.W, vec.Y} }
+func (vec Vector4) XXWZ() Vector4 { return Vector4{vec.X, vec.X, vec.W, vec.Z} }
+func (vec Vector4) XXWW() Vector4 { return Vector4{vec.X, vec.X, vec.W, vec.W} }
+func (vec Vector4) XYXX() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.X} }
+func (vec Vector4) XYXY() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.Y} }
+func (vec Vector4) XYXZ() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.Z} }
+func (vec Vector4) XYXW() Vector4 { return Vector4{vec.X, vec.Y, vec.X, vec.W} }
+func (vec Vector4) XYYX() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.X} }
+func (vec Vector4) XYYY() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4) XYYZ() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4) XYYW() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, vec.W} }
+func (vec Vector4) XYZX() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.X} }
+func (vec Vector4) XYZY() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4) XYZZ() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4) XYZW() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, vec.W} }
+func (vec Vector4) XYWX() Vector4 { return Vector4{vec.X, vec.Y, vec.W, vec.X} }
+func (vec Vector4) XYWY() Vector4 { return Vector4{vec.X, vec.Y, vec.W, vec.Y} }
+func (vec Vector4) XYWZ() Vector4 { return Vector4{vec.X, vec.Y, vec.W, vec.Z} }
+func (vec Vector4) XYWW() Vector4 { return Vector4{vec.X, vec.Y, vec.W, vec.W} }
+func (vec Vector4) XZXX() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.X} }
+func (vec Vector4) XZXY() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.Y} }
+func (vec Vector4) XZXZ() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.Z} }
+func (vec Vector4) XZXW() Vector4 { return Vector4{vec.X, vec.Z, vec.X, vec.W} }
+func (vec Vector4) XZYX() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.X} }
+func (vec Vector4) XZYY() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4) XZYZ() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4) XZYW() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, vec.W} }
+func (vec Vector4) XZZX() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.X} }
+func (vec Vector4) XZZY() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4) XZZZ() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4) XZZW() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, vec.W} }
+func (vec Vector4) XZWX() Vector4 { return Vector4{vec.X, vec.Z, vec.W, vec.X} }
+func (vec Vector4) XZWY() Vector4 { return Vector4{vec.X, vec.Z, vec.W, vec.Y} }
+func (vec Vector4) XZWZ() Vector4 { return Vector4{vec.X, vec.Z, vec.W, vec.Z} }
+func (vec Vector4) XZWW() Vector4 { return Vector4{vec.X, vec.Z, vec.W, vec.W} }
+func (vec Vector4) XWXX() Vector4 { return Vector4{vec.X, vec.W, vec.X, vec.X} }
+func (vec Vector4) XWXY() Vector4 { return Vector4{vec.X, vec.W, vec.X, vec.Y} }
+func (vec Vector4) XWXZ() Vector4 { return Vector4{vec.X, vec.W, vec.X, vec.Z} }
+func (vec Vector4) XWXW() Vector4 { return Vector4{vec.X, vec.W, vec.X, vec.W} }
+func (vec Vector4) XWYX() Vector4 { return Vector4{vec.X, vec.W, vec.Y, vec.X} }
+func (vec Vector4) XWYY() Vector4 { return Vector4{vec.X, vec.W, vec.Y, vec.Y} }
+func (vec Vector4) XWYZ() Vector4 { return Vector4{vec.X, vec.W, vec.Y, vec.Z} }
+func (vec Vector4) XWYW() Vector4 { return Vector4{vec.X, vec.W, vec.Y, vec.W} }
+func (vec Vector4) XWZX() Vector4 { return Vector4{vec.X, vec.W, vec.Z, vec.X} }
+func (vec Vector4) XWZY() Vector4 { return Vector4{vec.X, vec.W, vec.Z, vec.Y} }
+func (vec Vector4) XWZZ() Vector4 { return Vector4{vec.X, vec.W, vec.Z, vec.Z} }
+func (vec Vector4) XWZW() Vector4 { return Vector4{vec.X, vec.W, vec.Z, vec.W} }
+func (vec Vector4) XWWX() Vector4 { return Vector4{vec.X, vec.W, vec.W, vec.X} }
+func (vec Vector4) XWWY() Vector4 { return Vector4{vec.X, vec.W, vec.W, vec.Y} }
+func (vec Vector4) XWWZ() Vector4 { return Vector4{vec.X, vec.W, vec.W, vec.Z} }
+func (vec Vector4) XWWW() Vector4 { return Vector4{vec.X, vec.W, vec.W, vec.W} }
+func (vec Vector4) YXXX() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.X} }
+func (vec Vector4) YXXY() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.Y} }
+func (vec Vector4) YXXZ() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.Z} }
+func (vec Vector4) YXXW() Vector4 { return Vector4{vec.Y, vec.X, vec.X, vec.W} }
+func (vec Vector4) YXYX() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.X} }
+func (vec Vector4) YXYY() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.Y} }
+func (vec Vector4) YXYZ() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.Z} }
+func (vec Vector4) YXYW() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, vec.W} }
+func (vec Vector4) YXZX() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.X} }
+func (vec Vector4) YXZY() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.Y} }
+func (vec Vector4) YXZZ() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.Z} }
+func (vec Vector4) YXZW() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, vec.W} }
+func (vec Vector4) YXWX() Vector4 { return Vector4{vec.Y, vec.X, vec.W, vec.X} }
+func (vec Vector4) YXWY() Vector4 { return Vector4{vec.Y, vec.X, vec.W, vec.Y} }
+func (vec Vector4) YXWZ() Vector4 { return Vector4{vec.Y, vec.X, vec.W, vec.Z} }
+func (vec Vector4) YXWW() Vector4 { return Vector4{vec.Y, vec.X, vec.W, vec.W} }
+func (vec Vector4) YYXX() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.X} }
+func (vec Vector4) YYXY() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.Y} }
+func (vec Vector4) YYXZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.Z} }
+func (vec Vector4) YYXW() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, vec.W} }
+func (vec Vector4) YYYX() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.X} }
+func (vec Vector4) YYYY() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4) YYYZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4) YYYW() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, vec.W} }
+func (vec Vector4) YYZX() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.X} }
+func (vec Vector4) YYZY() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4) YYZZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4) YYZW() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, vec.W} }
+func (vec Vector4) YYWX() Vector4 { return Vector4{vec.Y, vec.Y, vec.W, vec.X} }
+func (vec Vector4) YYWY() Vector4 { return Vector4{vec.Y, vec.Y, vec.W, vec.Y} }
+func (vec Vector4) YYWZ() Vector4 { return Vector4{vec.Y, vec.Y, vec.W, vec.Z} }
+func (vec Vector4) YYWW() Vector4 { return Vector4{vec.Y, vec.Y, vec.W, vec.W} }
+func (vec Vector4) YZXX() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.X} }
+func (vec Vector4) YZXY() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.Y} }
+func (vec Vector4) YZXZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.Z} }
+func (vec Vector4) YZXW() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, vec.W} }
+func (vec Vector4) YZYX() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.X} }
+func (vec Vector4) YZYY() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4) YZYZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4) YZYW() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, vec.W} }
+func (vec Vector4) YZZX() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.X} }
+func (vec Vector4) YZZY() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4) YZZZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4) YZZW() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, vec.W} }
+func (vec Vector4) YZWX() Vector4 { return Vector4{vec.Y, vec.Z, vec.W, vec.X} }
+func (vec Vector4) YZWY() Vector4 { return Vector4{vec.Y, vec.Z, vec.W, vec.Y} }
+func (vec Vector4) YZWZ() Vector4 { return Vector4{vec.Y, vec.Z, vec.W, vec.Z} }
+func (vec Vector4) YZWW() Vector4 { return Vector4{vec.Y, vec.Z, vec.W, vec.W} }
+func (vec Vector4) YWXX() Vector4 { return Vector4{vec.Y, vec.W, vec.X, vec.X} }
+func (vec Vector4) YWXY() Vector4 { return Vector4{vec.Y, vec.W, vec.X, vec.Y} }
+func (vec Vector4) YWXZ() Vector4 { return Vector4{vec.Y, vec.W, vec.X, vec.Z} }
+func (vec Vector4) YWXW() Vector4 { return Vector4{vec.Y, vec.W, vec.X, vec.W} }
+func (vec Vector4) YWYX() Vector4 { return Vector4{vec.Y, vec.W, vec.Y, vec.X} }
+func (vec Vector4) YWYY() Vector4 { return Vector4{vec.Y, vec.W, vec.Y, vec.Y} }
+func (vec Vector4) YWYZ() Vector4 { return Vector4{vec.Y, vec.W, vec.Y, vec.Z} }
+func (vec Vector4) YWYW() Vector4 { return Vector4{vec.Y, vec.W, vec.Y, vec.W} }
+func (vec Vector4) YWZX() Vector4 { return Vector4{vec.Y, vec.W, vec.Z, vec.X} }
+func (vec Vector4) YWZY() Vector4 { return Vector4{vec.Y, vec.W, vec.Z, vec.Y} }
+func (vec Vector4) YWZZ() Vector4 { return Vector4{vec.Y, vec.W, vec.Z, vec.Z} }
+func (vec Vector4) YWZW() Vector4 { return Vector4{vec.Y, vec.W, vec.Z, vec.W} }
+func (vec Vector4) YWWX() Vector4 { return Vector4{vec.Y, vec.W, vec.W, vec.X} }
+func (vec Vector4) YWWY() Vector4 { return Vector4{vec.Y, vec.W, vec.W, vec.Y} }
+func (vec Vector4) YWWZ() Vector4 { return Vector4{vec.Y, vec.W, vec.W, vec.Z} }
+func (vec Vector4) YWWW() Vector4 { return Vector4{vec.Y, vec.W, vec.W, vec.W} }
+func (vec Vector4) ZXXX() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.X} }
+func (vec Vector4) ZXXY() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.Y} }
+func (vec Vector4) ZXXZ() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.Z} }
+func (vec Vector4) ZXXW() Vector4 { return Vector4{vec.Z, vec.X, vec.X, vec.W} }
+func (vec Vector4) ZXYX() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.X} }
+func (vec Vector4) ZXYY() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.Y} }
+func (vec Vector4) ZXYZ() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.Z} }
+func (vec Vector4) ZXYW() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, vec.W} }
+func (vec Vector4) ZXZX() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.X} }
+func (vec Vector4) ZXZY() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.Y} }
+func (vec Vector4) ZXZZ() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.Z} }
+func (vec Vector4) ZXZW() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, vec.W} }
+func (vec Vector4) ZXWX() Vector4 { return Vector4{vec.Z, vec.X, vec.W, vec.X} }
+func (vec Vector4) ZXWY() Vector4 { return Vector4{vec.Z, vec.X, vec.W, vec.Y} }
+func (vec Vector4) ZXWZ() Vector4 { return Vector4{vec.Z, vec.X, vec.W, vec.Z} }
+func (vec Vector4) ZXWW() Vector4 { return Vector4{vec.Z, vec.X, vec.W, vec.W} }
+func (vec Vector4) ZYXX() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.X} }
+func (vec Vector4) ZYXY() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.Y} }
+func (vec Vector4) ZYXZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.Z} }
+func (vec Vector4) ZYXW() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, vec.W} }
+func (vec Vector4) ZYYX() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.X} }
+func (vec Vector4) ZYYY() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4) ZYYZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4) ZYYW() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, vec.W} }
+func (vec Vector4) ZYZX() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.X} }
+func (vec Vector4) ZYZY() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4) ZYZZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4) ZYZW() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, vec.W} }
+func (vec Vector4) ZYWX() Vector4 { return Vector4{vec.Z, vec.Y, vec.W, vec.X} }
+func (vec Vector4) ZYWY() Vector4 { return Vector4{vec.Z, vec.Y, vec.W, vec.Y} }
+func (vec Vector4) ZYWZ() Vector4 { return Vector4{vec.Z, vec.Y, vec.W, vec.Z} }
+func (vec Vector4) ZYWW() Vector4 { return Vector4{vec.Z, vec.Y, vec.W, vec.W} }
+func (vec Vector4) ZZXX() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.X} }
+func (vec Vector4) ZZXY() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.Y} }
+func (vec Vector4) ZZXZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.Z} }
+func (vec Vector4) ZZXW() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, vec.W} }
+func (vec Vector4) ZZYX() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.X} }
+func (vec Vector4) ZZYY() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4) ZZYZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4) ZZYW() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, vec.W} }
+func (vec Vector4) ZZZX() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.X} }
+func (vec Vector4) ZZZY() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4) ZZZZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4) ZZZW() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, vec.W} }
+func (vec Vector4) ZZWX() Vector4 { return Vector4{vec.Z, vec.Z, vec.W, vec.X} }
+func (vec Vector4) ZZWY() Vector4 { return Vector4{vec.Z, vec.Z, vec.W, vec.Y} }
+func (vec Vector4) ZZWZ() Vector4 { return Vector4{vec.Z, vec.Z, vec.W, vec.Z} }
+func (vec Vector4) ZZWW() Vector4 { return Vector4{vec.Z, vec.Z, vec.W, vec.W} }
+func (vec Vector4) ZWXX() Vector4 { return Vector4{vec.Z, vec.W, vec.X, vec.X} }
+func (vec Vector4) ZWXY() Vector4 { return Vector4{vec.Z, vec.W, vec.X, vec.Y} }
+func (vec Vector4) ZWXZ() Vector4 { return Vector4{vec.Z, vec.W, vec.X, vec.Z} }
+func (vec Vector4) ZWXW() Vector4 { return Vector4{vec.Z, vec.W, vec.X, vec.W} }
+func (vec Vector4) ZWYX() Vector4 { return Vector4{vec.Z, vec.W, vec.Y, vec.X} }
+func (vec Vector4) ZWYY() Vector4 { return Vector4{vec.Z, vec.W, vec.Y, vec.Y} }
+func (vec Vector4) ZWYZ() Vector4 { return Vector4{vec.Z, vec.W, vec.Y, vec.Z} }
+func (vec Vector4) ZWYW() Vector4 { return Vector4{vec.Z, vec.W, vec.Y, vec.W} }
+func (vec Vector4) ZWZX() Vector4 { return Vector4{vec.Z, vec.W, vec.Z, vec.X} }
+func (vec Vector4) ZWZY() Vector4 { return Vector4{vec.Z, vec.W, vec.Z, vec.Y} }
+func (vec Vector4) ZWZZ() Vector4 { return Vector4{vec.Z, vec.W, vec.Z, vec.Z} }
+func (vec Vector4) ZWZW() Vector4 { return Vector4{vec.Z, vec.W, vec.Z, vec.W} }
+func (vec Vector4) ZWWX() Vector4 { return Vector4{vec.Z, vec.W, vec.W, vec.X} }
+func (vec Vector4) ZWWY() Vector4 { return Vector4{vec.Z, vec.W, vec.W, vec.Y} }
+func (vec Vector4) ZWWZ() Vector4 { return Vector4{vec.Z, vec.W, vec.W, vec.Z} }
+func (vec Vector4) ZWWW() Vector4 { return Vector4{vec.Z, vec.W, vec.W, vec.W} }
+func (vec Vector4) WXXX() Vector4 { return Vector4{vec.W, vec.X, vec.X, vec.X} }
+func (vec Vector4) WXXY() Vector4 { return Vector4{vec.W, vec.X, vec.X, vec.Y} }
+func (vec Vector4) WXXZ() Vector4 { return Vector4{vec.W, vec.X, vec.X, vec.Z} }
+func (vec Vector4) WXXW() Vector4 { return Vector4{vec.W, vec.X, vec.X, vec.W} }
+func (vec Vector4) WXYX() Vector4 { return Vector4{vec.W, vec.X, vec.Y, vec.X} }
+func (vec Vector4) WXYY() Vector4 { return Vector4{vec.W, vec.X, vec.Y, vec.Y} }
+func (vec Vector4) WXYZ() Vector4 { return Vector4{vec.W, vec.X, vec.Y, vec.Z} }
+func (vec Vector4) WXYW() Vector4 { return Vector4{vec.W, vec.X, vec.Y, vec.W} }
+func (vec Vector4) WXZX() Vector4 { return Vector4{vec.W, vec.X, vec.Z, vec.X} }
+func (vec Vector4) WXZY() Vector4 { return Vector4{vec.W, vec.X, vec.Z, vec.Y} }
+func (vec Vector4) WXZZ() Vector4 { return Vector4{vec.W, vec.X, vec.Z, vec.Z} }
+func (vec Vector4) WXZW() Vector4 { return Vector4{vec.W, vec.X, vec.Z, vec.W} }
+func (vec Vector4) WXWX() Vector4 { return Vector4{vec.W, vec.X, vec.W, vec.X} }
+func (vec Vector4) WXWY() Vector4 { return Vector4{vec.W, vec.X, vec.W, vec.Y} }
+func (vec Vector4) WXWZ() Vector4 { return Vector4{vec.W, vec.X, vec.W, vec.Z} }
+func (vec Vector4) WXWW() Vector4 { return Vector4{vec.W, vec.X, vec.W, vec.W} }
+func (vec Vector4) WYXX() Vector4 { return Vector4{vec.W, vec.Y, vec.X, vec.X} }
+func (vec Vector4) WYXY() Vector4 { return Vector4{vec.W, vec.Y, vec.X, vec.Y} }
+func (vec Vector4) WYXZ() Vector4 { return Vector4{vec.W, vec.Y, vec.X, vec.Z} }
+func (vec Vector4) WYXW() Vector4 { return Vector4{vec.W, vec.Y, vec.X, vec.W} }
+func (vec Vector4) WYYX() Vector4 { return Vector4{vec.W, vec.Y, vec.Y, vec.X} }
+func (vec Vector4) WYYY() Vector4 { return Vector4{vec.W, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4) WYYZ() Vector4 { return Vector4{vec.W, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4) WYYW() Vector4 { return Vector4{vec.W, vec.Y, vec.Y, vec.W} }
+func (vec Vector4) WYZX() Vector4 { return Vector4{vec.W, vec.Y, vec.Z, vec.X} }
+func (vec Vector4) WYZY() Vector4 { return Vector4{vec.W, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4) WYZZ() Vector4 { return Vector4{vec.W, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4) WYZW() Vector4 { return Vector4{vec.W, vec.Y, vec.Z, vec.W} }
+func (vec Vector4) WYWX() Vector4 { return Vector4{vec.W, vec.Y, vec.W, vec.X} }
+func (vec Vector4) WYWY() Vector4 { return Vector4{vec.W, vec.Y, vec.W, vec.Y} }
+func (vec Vector4) WYWZ() Vector4 { return Vector4{vec.W, vec.Y, vec.W, vec.Z} }
+func (vec Vector4) WYWW() Vector4 { return Vector4{vec.W, vec.Y, vec.W, vec.W} }
+func (vec Vector4) WZXX() Vector4 { return Vector4{vec.W, vec.Z, vec.X, vec.X} }
+func (vec Vector4) WZXY() Vector4 { return Vector4{vec.W, vec.Z, vec.X, vec.Y} }
+func (vec Vector4) WZXZ() Vector4 { return Vector4{vec.W, vec.Z, vec.X, vec.Z} }
+func (vec Vector4) WZXW() Vector4 { return Vector4{vec.W, vec.Z, vec.X, vec.W} }
+func (vec Vector4) WZYX() Vector4 { return Vector4{vec.W, vec.Z, vec.Y, vec.X} }
+func (vec Vector4) WZYY() Vector4 { return Vector4{vec.W, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4) WZYZ() Vector4 { return Vector4{vec.W, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4) WZYW() Vector4 { return Vector4{vec.W, vec.Z, vec.Y, vec.W} }
+func (vec Vector4) WZZX() Vector4 { return Vector4{vec.W, vec.Z, vec.Z, vec.X} }
+func (vec Vector4) WZZY() Vector4 { return Vector4{vec.W, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4) WZZZ() Vector4 { return Vector4{vec.W, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4) WZZW() Vector4 { return Vector4{vec.W, vec.Z, vec.Z, vec.W} }
+func (vec Vector4) WZWX() Vector4 { return Vector4{vec.W, vec.Z, vec.W, vec.X} }
+func (vec Vector4) WZWY() Vector4 { return Vector4{vec.W, vec.Z, vec.W, vec.Y} }
+func (vec Vector4) WZWZ() Vector4 { return Vector4{vec.W, vec.Z, vec.W, vec.Z} }
+func (vec Vector4) WZWW() Vector4 { return Vector4{vec.W, vec.Z, vec.W, vec.W} }
+func (vec Vector4) WWXX() Vector4 { return Vector4{vec.W, vec.W, vec.X, vec.X} }
+func (vec Vector4) WWXY() Vector4 { return Vector4{vec.W, vec.W, vec.X, vec.Y} }
+func (vec Vector4) WWXZ() Vector4 { return Vector4{vec.W, vec.W, vec.X, vec.Z} }
+func (vec Vector4) WWXW() Vector4 { return Vector4{vec.W, vec.W, vec.X, vec.W} }
+func (vec Vector4) WWYX() Vector4 { return Vector4{vec.W, vec.W, vec.Y, vec.X} }
+func (vec Vector4) WWYY() Vector4 { return Vector4{vec.W, vec.W, vec.Y, vec.Y} }
+func (vec Vector4) WWYZ() Vector4 { return Vector4{vec.W, vec.W, vec.Y, vec.Z} }
+func (vec Vector4) WWYW() Vector4 { return Vector4{vec.W, vec.W, vec.Y, vec.W} }
+func (vec Vector4) WWZX() Vector4 { return Vector4{vec.W, vec.W, vec.Z, vec.X} }
+func (vec Vector4) WWZY() Vector4 { return Vector4{vec.W, vec.W, vec.Z, vec.Y} }
+func (vec Vector4) WWZZ() Vector4 { return Vector4{vec.W, vec.W, vec.Z, vec.Z} }
+func (vec Vector4) WWZW() Vector4 { return Vector4{vec.W, vec.W, vec.Z, vec.W} }
+func (vec Vector4) WWWX() Vector4 { return Vector4{vec.W, vec.W, vec.W, vec.X} }
+func (vec Vector4) WWWY() Vector4 { return Vector4{vec.W, vec.W, vec.W, vec.Y} }
+func (vec Vector4) WWWZ() Vector4 { return Vector4{vec.W, vec.W, vec.W, vec.Z} }
+func (vec Vector4) WWWW() Vector4 { return Vector4{vec.W, vec.W, vec.W, vec.W} }
+func (vec Vector4) X0() Vector2   { return Vector2{vec.X, 0} }
+func (vec Vector4) X1() Vector2   { return Vector2{vec.X, 1} }
+func (vec Vector4) Y0() Vector2   { return Vector2{vec.Y, 0} }
+func (vec Vector4) Y1() Vector2   { return Vector2{vec.Y, 1} }
+func (vec Vector4) Z0() Vector2   { return Vector2{vec.Z, 0} }
+func (vec Vector4) Z1() Vector2   { return Vector2{vec.Z, 1} }
+func (vec Vector4) W0() Vector2   { return Vector2{vec.W, 0} }
+func (vec Vector4) W1() Vector2   { return Vector2{vec.W, 1} }
+func (vec Vector4) XX0() Vector3  { return Vector3{vec.X, vec.X, 0} }
+func (vec Vector4) XX1() Vector3  { return Vector3{vec.X, vec.X, 1} }
+func (vec Vector4) XY0() Vector3  { return Vector3{vec.X, vec.Y, 0} }
+func (vec Vector4) XY1() Vector3  { return Vector3{vec.X, vec.Y, 1} }
+func (vec Vector4) XZ0() Vector3  { return Vector3{vec.X, vec.Z, 0} }
+func (vec Vector4) XZ1() Vector3  { return Vector3{vec.X, vec.Z, 1} }
+func (vec Vector4) XW0() Vector3  { return Vector3{vec.X, vec.W, 0} }
+func (vec Vector4) XW1() Vector3  { return Vector3{vec.X, vec.W, 1} }
+func (vec Vector4) YX0() Vector3  { return Vector3{vec.Y, vec.X, 0} }
+func (vec Vector4) YX1() Vector3  { return Vector3{vec.Y, vec.X, 1} }
+func (vec Vector4) YY0() Vector3  { return Vector3{vec.Y, vec.Y, 0} }
+func (vec Vector4) YY1() Vector3  { return Vector3{vec.Y, vec.Y, 1} }
+func (vec Vector4) YZ0() Vector3  { return Vector3{vec.Y, vec.Z, 0} }
+func (vec Vector4) YZ1() Vector3  { return Vector3{vec.Y, vec.Z, 1} }
+func (vec Vector4) YW0() Vector3  { return Vector3{vec.Y, vec.W, 0} }
+func (vec Vector4) YW1() Vector3  { return Vector3{vec.Y, vec.W, 1} }
+func (vec Vector4) ZX0() Vector3  { return Vector3{vec.Z, vec.X, 0} }
+func (vec Vector4) ZX1() Vector3  { return Vector3{vec.Z, vec.X, 1} }
+func (vec Vector4) ZY0() Vector3  { return Vector3{vec.Z, vec.Y, 0} }
+func (vec Vector4) ZY1() Vector3  { return Vector3{vec.Z, vec.Y, 1} }
+func (vec Vector4) ZZ0() Vector3  { return Vector3{vec.Z, vec.Z, 0} }
+func (vec Vector4) ZZ1() Vector3  { return Vector3{vec.Z, vec.Z, 1} }
+func (vec Vector4) ZW0() Vector3  { return Vector3{vec.Z, vec.W, 0} }
+func (vec Vector4) ZW1() Vector3  { return Vector3{vec.Z, vec.W, 1} }
+func (vec Vector4) WX0() Vector3  { return Vector3{vec.W, vec.X, 0} }
+func (vec Vector4) WX1() Vector3  { return Vector3{vec.W, vec.X, 1} }
+func (vec Vector4) WY0() Vector3  { return Vector3{vec.W, vec.Y, 0} }
+func (vec Vector4) WY1() Vector3  { return Vector3{vec.W, vec.Y, 1} }
+func (vec Vector4) WZ0() Vector3  { return Vector3{vec.W, vec.Z, 0} }
+func (vec Vector4) WZ1() Vector3  { return Vector3{vec.W, vec.Z, 1} }
+func (vec Vector4) WW0() Vector3  { return Vector3{vec.W, vec.W, 0} }
+func (vec Vector4) WW1() Vector3  { return Vector3{vec.W, vec.W, 1} }
+func (vec Vector4) XXX0() Vector4 { return Vector4{vec.X, vec.X, vec.X, 0} }
+func (vec Vector4) XXX1() Vector4 { return Vector4{vec.X, vec.X, vec.X, 1} }
+func (vec Vector4) XXY0() Vector4 { return Vector4{vec.X, vec.X, vec.Y, 0} }
+func (vec Vector4) XXY1() Vector4 { return Vector4{vec.X, vec.X, vec.Y, 1} }
+func (vec Vector4) XXZ0() Vector4 { return Vector4{vec.X, vec.X, vec.Z, 0} }
+func (vec Vector4) XXZ1() Vector4 { return Vector4{vec.X, vec.X, vec.Z, 1} }
+func (vec Vector4) XXW0() Vector4 { return Vector4{vec.X, vec.X, vec.W, 0} }
+func (vec Vector4) XXW1() Vector4 { return Vector4{vec.X, vec.X, vec.W, 1} }
+func (vec Vector4) XYX0() Vector4 { return Vector4{vec.X, vec.Y, vec.X, 0} }
+func (vec Vector4) XYX1() Vector4 { return Vector4{vec.X, vec.Y, vec.X, 1} }
+func (vec Vector4) XYY0() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, 0} }
+func (vec Vector4) XYY1() Vector4 { return Vector4{vec.X, vec.Y, vec.Y, 1} }
+func (vec Vector4) XYZ0() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, 0} }
+func (vec Vector4) XYZ1() Vector4 { return Vector4{vec.X, vec.Y, vec.Z, 1} }
+func (vec Vector4) XYW0() Vector4 { return Vector4{vec.X, vec.Y, vec.W, 0} }
+func (vec Vector4) XYW1() Vector4 { return Vector4{vec.X, vec.Y, vec.W, 1} }
+func (vec Vector4) XZX0() Vector4 { return Vector4{vec.X, vec.Z, vec.X, 0} }
+func (vec Vector4) XZX1() Vector4 { return Vector4{vec.X, vec.Z, vec.X, 1} }
+func (vec Vector4) XZY0() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, 0} }
+func (vec Vector4) XZY1() Vector4 { return Vector4{vec.X, vec.Z, vec.Y, 1} }
+func (vec Vector4) XZZ0() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, 0} }
+func (vec Vector4) XZZ1() Vector4 { return Vector4{vec.X, vec.Z, vec.Z, 1} }
+func (vec Vector4) XZW0() Vector4 { return Vector4{vec.X, vec.Z, vec.W, 0} }
+func (vec Vector4) XZW1() Vector4 { return Vector4{vec.X, vec.Z, vec.W, 1} }
+func (vec Vector4) XWX0() Vector4 { return Vector4{vec.X, vec.W, vec.X, 0} }
+func (vec Vector4) XWX1() Vector4 { return Vector4{vec.X, vec.W, vec.X, 1} }
+func (vec Vector4) XWY0() Vector4 { return Vector4{vec.X, vec.W, vec.Y, 0} }
+func (vec Vector4) XWY1() Vector4 { return Vector4{vec.X, vec.W, vec.Y, 1} }
+func (vec Vector4) XWZ0() Vector4 { return Vector4{vec.X, vec.W, vec.Z, 0} }
+func (vec Vector4) XWZ1() Vector4 { return Vector4{vec.X, vec.W, vec.Z, 1} }
+func (vec Vector4) XWW0() Vector4 { return Vector4{vec.X, vec.W, vec.W, 0} }
+func (vec Vector4) XWW1() Vector4 { return Vector4{vec.X, vec.W, vec.W, 1} }
+func (vec Vector4) YXX0() Vector4 { return Vector4{vec.Y, vec.X, vec.X, 0} }
+func (vec Vector4) YXX1() Vector4 { return Vector4{vec.Y, vec.X, vec.X, 1} }
+func (vec Vector4) YXY0() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, 0} }
+func (vec Vector4) YXY1() Vector4 { return Vector4{vec.Y, vec.X, vec.Y, 1} }
+func (vec Vector4) YXZ0() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, 0} }
+func (vec Vector4) YXZ1() Vector4 { return Vector4{vec.Y, vec.X, vec.Z, 1} }
+func (vec Vector4) YXW0() Vector4 { return Vector4{vec.Y, vec.X, vec.W, 0} }
+func (vec Vector4) YXW1() Vector4 { return Vector4{vec.Y, vec.X, vec.W, 1} }
+func (vec Vector4) YYX0() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, 0} }
+func (vec Vector4) YYX1() Vector4 { return Vector4{vec.Y, vec.Y, vec.X, 1} }
+func (vec Vector4) YYY0() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, 0} }
+func (vec Vector4) YYY1() Vector4 { return Vector4{vec.Y, vec.Y, vec.Y, 1} }
+func (vec Vector4) YYZ0() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, 0} }
+func (vec Vector4) YYZ1() Vector4 { return Vector4{vec.Y, vec.Y, vec.Z, 1} }
+func (vec Vector4) YYW0() Vector4 { return Vector4{vec.Y, vec.Y, vec.W, 0} }
+func (vec Vector4) YYW1() Vector4 { return Vector4{vec.Y, vec.Y, vec.W, 1} }
+func (vec Vector4) YZX0() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, 0} }
+func (vec Vector4) YZX1() Vector4 { return Vector4{vec.Y, vec.Z, vec.X, 1} }
+func (vec Vector4) YZY0() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, 0} }
+func (vec Vector4) YZY1() Vector4 { return Vector4{vec.Y, vec.Z, vec.Y, 1} }
+func (vec Vector4) YZZ0() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, 0} }
+func (vec Vector4) YZZ1() Vector4 { return Vector4{vec.Y, vec.Z, vec.Z, 1} }
+func (vec Vector4) YZW0() Vector4 { return Vector4{vec.Y, vec.Z, vec.W, 0} }
+func (vec Vector4) YZW1() Vector4 { return Vector4{vec.Y, vec.Z, vec.W, 1} }
+func (vec Vector4) YWX0() Vector4 { return Vector4{vec.Y, vec.W, vec.X, 0} }
+func (vec Vector4) YWX1() Vector4 { return Vector4{vec.Y, vec.W, vec.X, 1} }
+func (vec Vector4) YWY0() Vector4 { return Vector4{vec.Y, vec.W, vec.Y, 0} }
+func (vec Vector4) YWY1() Vector4 { return Vector4{vec.Y, vec.W, vec.Y, 1} }
+func (vec Vector4) YWZ0() Vector4 { return Vector4{vec.Y, vec.W, vec.Z, 0} }
+func (vec Vector4) YWZ1() Vector4 { return Vector4{vec.Y, vec.W, vec.Z, 1} }
+func (vec Vector4) YWW0() Vector4 { return Vector4{vec.Y, vec.W, vec.W, 0} }
+func (vec Vector4) YWW1() Vector4 { return Vector4{vec.Y, vec.W, vec.W, 1} }
+func (vec Vector4) ZXX0() Vector4 { return Vector4{vec.Z, vec.X, vec.X, 0} }
+func (vec Vector4) ZXX1() Vector4 { return Vector4{vec.Z, vec.X, vec.X, 1} }
+func (vec Vector4) ZXY0() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, 0} }
+func (vec Vector4) ZXY1() Vector4 { return Vector4{vec.Z, vec.X, vec.Y, 1} }
+func (vec Vector4) ZXZ0() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, 0} }
+func (vec Vector4) ZXZ1() Vector4 { return Vector4{vec.Z, vec.X, vec.Z, 1} }
+func (vec Vector4) ZXW0() Vector4 { return Vector4{vec.Z, vec.X, vec.W, 0} }
+func (vec Vector4) ZXW1() Vector4 { return Vector4{vec.Z, vec.X, vec.W, 1} }
+func (vec Vector4) ZYX0() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, 0} }
+func (vec Vector4) ZYX1() Vector4 { return Vector4{vec.Z, vec.Y, vec.X, 1} }
+func (vec Vector4) ZYY0() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, 0} }
+func (vec Vector4) ZYY1() Vector4 { return Vector4{vec.Z, vec.Y, vec.Y, 1} }
+func (vec Vector4) ZYZ0() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, 0} }
+func (vec Vector4) ZYZ1() Vector4 { return Vector4{vec.Z, vec.Y, vec.Z, 1} }
+func (vec Vector4) ZYW0() Vector4 { return Vector4{vec.Z, vec.Y, vec.W, 0} }
+func (vec Vector4) ZYW1() Vector4 { return Vector4{vec.Z, vec.Y, vec.W, 1} }
+func (vec Vector4) ZZX0() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, 0} }
+func (vec Vector4) ZZX1() Vector4 { return Vector4{vec.Z, vec.Z, vec.X, 1} }
+func (vec Vector4) ZZY0() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, 0} }
+func (vec Vector4) ZZY1() Vector4 { return Vector4{vec.Z, vec.Z, vec.Y, 1} }
+func (vec Vector4) ZZZ0() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, 0} }
+func (vec Vector4) ZZZ1() Vector4 { return Vector4{vec.Z, vec.Z, vec.Z, 1} }
+func (vec Vector4) ZZW0() Vector4 { return Vector4{vec.Z, vec.Z, vec.W, 0} }
+func (vec Vector4) ZZW1() Vector4 { return Vector4{vec.Z, vec.Z, vec.W, 1} }
+func (vec Vector4) ZWX0() Vector4 { return Vector4{vec.Z, vec.W, vec.X, 0} }
+func (vec Vector4) ZWX1() Vector4 { return Vector4{vec.Z, vec.W, vec.X, 1} }
+func (vec Vector4) ZWY0() Vector4 { return Vector4{vec.Z, vec.W, vec.Y, 0} }
+func (vec Vector4) ZWY1() Vector4 { return Vector4{vec.Z, vec.W, vec.Y, 1} }
+func (vec Vector4) ZWZ0() Vector4 { return Vector4{vec.Z, vec.W, vec.Z, 0} }
+func (vec Vector4) ZWZ1() Vector4 { return Vector4{vec.Z, vec.W, vec.Z, 1} }
+func (vec Vector4) ZWW0() Vector4 { return Vector4{vec.Z, vec.W, vec.W, 0} }
+func (vec Vector4) ZWW1() Vector4 { return Vector4{vec.Z, vec.W, vec.W, 1} }
+func (vec Vector4) WXX0() Vector4 { return Vector4{vec.W, vec.X, vec.X, 0} }
+func (vec Vector4) WXX1() Vector4 { return Vector4{vec.W, vec.X, vec.X, 1} }
+func (vec Vector4) WXY0() Vector4 { return Vector4{vec.W, vec.X, vec.Y, 0} }
+func (vec Vector4) WXY1() Vector4 { return Vector4{vec.W, vec.X, vec.Y, 1} }
+func (vec Vector4) WXZ0() Vector4 { return Vector4{vec.W, vec.X, vec.Z, 0} }
+func (vec Vector4) WXZ1() Vector4 { return Vector4{vec.W, vec.X, vec.Z, 1} }
+func (vec Vector4) WXW0() Vector4 { return Vector4{vec.W, vec.X, vec.W, 0} }
+func (vec Vector4) WXW1() Vector4 { return Vector4{vec.W, vec.X, vec.W, 1} }
+func (vec Vector4) WYX0() Vector4 { return Vector4{vec.W, vec.Y, vec.X, 0} }
+func (vec Vector4) WYX1() Vector4 { return Vector4{vec.W, vec.Y, vec.X, 1} }
+func (vec Vector4) WYY0() Vector4 { return Vector4{vec.W, vec.Y, vec.Y, 0} }
+func (vec Vector4) WYY1() Vector4 { return Vector4{vec.W, vec.Y, vec.Y, 1} }
+func (vec Vector4) WYZ0() Vector4 { return Vector4{vec.W, vec.Y, vec.Z, 0} }
+func (vec Vector4) WYZ1() Vector4 { return Vector4{vec.W, vec.Y, vec.Z, 1} }
+func (vec Vector4) WYW0() Vector4 { return Vector4{vec.W, vec.Y, vec.W, 0} }
+func (vec Vector4) WYW1() Vector4 { return Vector4{vec.W, vec.Y, vec.W, 1} }
+func (vec Vector4) WZX0() Vector4 { return Vector4{vec.W, vec.Z, vec.X, 0} }
+func (vec Vector4) WZX1() Vector4 { return Vector4{vec.W, vec.Z, vec.X, 1} }
+func (vec Vector4) WZY0() Vector4 { return Vector4{vec.W, vec.Z, vec.Y, 0} }
+func (vec Vector4) WZY1() Vector4 { return Vector4{vec.W, vec.Z, vec.Y, 1} }
+func (vec Vector4) WZZ0() Vector4 { return Vector4{vec.W, vec.Z, vec.Z, 0} }
+func (vec Vector4) WZZ1() Vector4 { return Vector4{vec.W, vec.Z, vec.Z, 1} }
+func (vec Vector4) WZW0() Vector4 { return Vector4{vec.W, vec.Z, vec.W, 0} }
+func (vec Vector4) WZW1() Vector4 { return Vector4{vec.W, vec.Z, vec.W, 1} }
+func (vec Vector4) WWX0() Vector4 { return Vector4{vec.W, vec.W, vec.X, 0} }
+func (vec Vector4) WWX1() Vector4 { return Vector4{vec.W, vec.W, vec.X, 1} }
+func (vec Vector4) WWY0() Vector4 { return Vector4{vec.W, vec.W, vec.Y, 0} }
+func (vec Vector4) WWY1() Vector4 { return Vector4{vec.W, vec.W, vec.Y, 1} }
+func (vec Vector4) WWZ0() Vector4 { return Vector4{vec.W, vec.W, vec.Z, 0} }
+func (vec Vector4) WWZ1() Vector4 { return Vector4{vec.W, vec.W, vec.Z, 1} }
+func (vec Vector4) WWW0() Vector4 { return Vector4{vec.W, vec.W, vec.W, 0} }
+func (vec Vector4) WWW1() Vector4 { return Vector4{vec.W, vec.W, vec.W, 1} }
@@ -0,0 +1,767 @@
+// Code generated by swizzlegen.go; DO NOT EDIT.
+
+package math
+
+// Swizzles of Vector2d
+
+func (vec Vector2d) XX() Vector2d   { return Vector2d{vec.X, vec.X} }
+func (vec Vector2d) XY() Vector2d   { return Vector2d{vec.X, vec.Y} }
+func (vec Vector2d) YX() Vector2d   { return Vector2d{vec.Y, vec.X} }
+func (vec Vector2d) YY() Vector2d   { return Vector2d{vec.Y, vec.Y} }
+func (vec Vector2d) XXX() Vector3d  { return Vector3d{vec.X, vec.X, vec.X} }
+func (vec Vector2d) XXY() Vector3d  { return Vector3d{vec.X, vec.X, vec.Y} }
+func (vec Vector2d) XYX() Vector3d  { return Vector3d{vec.X, vec.Y, vec.X} }
+func (vec Vector2d) XYY() Vector3d  { return Vector3d{vec.X, vec.Y, vec.Y} }
+func (vec Vector2d) YXX() Vector3d  { return Vector3d{vec.Y, vec.X, vec.X} }
+func (vec Vector2d) YXY() Vector3d  { return Vector3d{vec.Y, vec.X, vec.Y} }
+func (vec Vector2d) YYX() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.X} }
+func (vec Vector2d) YYY() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.Y} }
+func (vec Vector2d) XXXX() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.X} }
+func (vec Vector2d) XXXY() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.Y} }
+func (vec Vector2d) XXYX() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.X} }
+func (vec Vector2d) XXYY() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.Y} }
+func (vec Vector2d) XYXX() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.X} }
+func (vec Vector2d) XYXY() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.Y} }
+func (vec Vector2d) XYYX() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.X} }
+func (vec Vector2d) XYYY() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.Y} }
+func (vec Vector2d) YXXX() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.X} }
+func (vec Vector2d) YXXY() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.Y} }
+func (vec Vector2d) YXYX() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.X} }
+func (vec Vector2d) YXYY() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.Y} }
+func (vec Vector2d) YYXX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.X} }
+func (vec Vector2d) YYXY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.Y} }
+func (vec Vector2d) YYYX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.X} }
+func (vec Vector2d) YYYY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.Y} }
+func (vec Vector2d) X0() Vector2d   { return Vector2d{vec.X, 0} }
+func (vec Vector2d) X1() Vector2d   { return Vector2d{vec.X, 1} }
+func (vec Vector2d) Y0() Vector2d   { return Vector2d{vec.Y, 0} }
+func (vec Vector2d) Y1() Vector2d   { return Vector2d{vec.Y, 1} }
+func (vec Vector2d) XX0() Vector3d  { return Vector3d{vec.X, vec.X, 0} }
+func (vec Vector2d) XX1() Vector3d  { return Vector3d{vec.X, vec.X, 1} }
+func (vec Vector2d) XY0() Vector3d  { return Vector3d{vec.X, vec.Y, 0} }
+func (vec Vector2d) XY1() Vector3d  { return Vector3d{vec.X, vec.Y, 1} }
+func (vec Vector2d) YX0() Vector3d  { return Vector3d{vec.Y, vec.X, 0} }
+func (vec Vector2d) YX1() Vector3d  { return Vector3d{vec.Y, vec.X, 1} }
+func (vec Vector2d) YY0() Vector3d  { return Vector3d{vec.Y, vec.Y, 0} }
+func (vec Vector2d) YY1() Vector3d  { return Vector3d{vec.Y, vec.Y, 1} }
+func (vec Vector2d) XXX0() Vector4d { return Vector4d{vec.X, vec.X, vec.X, 0} }
+func (vec Vector2d) XXX1() Vector4d { return Vector4d{vec.X, vec.X, vec.X, 1} }
+func (vec Vector2d) XXY0() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, 0} }
+func (vec Vector2d) XXY1() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, 1} }
+func (vec Vector2d) XYX0() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, 0} }
+func (vec Vector2d) XYX1() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, 1} }
+func (vec Vector2d) XYY0() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, 0} }
+func (vec Vector2d) XYY1() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, 1} }
+func (vec Vector2d) YXX0() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, 0} }
+func (vec Vector2d) YXX1() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, 1} }
+func (vec Vector2d) YXY0() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, 0} }
+func (vec Vector2d) YXY1() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, 1} }
+func (vec Vector2d) YYX0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, 0} }
+func (vec Vector2d) YYX1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, 1} }
+func (vec Vector2d) YYY0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, 0} }
+func (vec Vector2d) YYY1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, 1} }
+
+// Swizzles of Vector3d
+
+func (vec Vector3d) XX() Vector2d   { return Vector2d{vec.X, vec.X} }
+func (vec Vector3d) XY() Vector2d   { return Vector2d{vec.X, vec.Y} }
+func (vec Vector3d) XZ() Vector2d   { return Vector2d{vec.X, vec.Z} }
+func (vec Vector3d) YX() Vector2d   { return Vector2d{vec.Y, vec.X} }
+func (vec Vector3d) YY() Vector2d   { return Vector2d{vec.Y, vec.Y} }
+func (vec Vector3d) YZ() Vector2d   { return Vector2d{vec.Y, vec.Z} }
+func (vec Vector3d) ZX() Vector2d   { return Vector2d{vec.Z, vec.X} }
+func (vec Vector3d) ZY() Vector2d   { return Vector2d{vec.Z, vec.Y} }
+func (vec Vector3d) ZZ() Vector2d   { return Vector2d{vec.Z, vec.Z} }
+func (vec Vector3d) XXX() Vector3d  { return Vector3d{vec.X, vec.X, vec.X} }
+func (vec Vector3d) XXY() Vector3d  { return Vector3d{vec.X, vec.X, vec.Y} }
+func (vec Vector3d) XXZ() Vector3d  { return Vector3d{vec.X, vec.X, vec.Z} }
+func (vec Vector3d) XYX() Vector3d  { return Vector3d{vec.X, vec.Y, vec.X} }
+func (vec Vector3d) XYY() Vector3d  { return Vector3d{vec.X, vec.Y, vec.Y} }
+func (vec Vector3d) XYZ() Vector3d  { return Vector3d{vec.X, vec.Y, vec.Z} }
+func (vec Vector3d) XZX() Vector3d  { return Vector3d{vec.X, vec.Z, vec.X} }
+func (vec Vector3d) XZY() Vector3d  { return Vector3d{vec.X, vec.Z, vec.Y} }
+func (vec Vector3d) XZZ() Vector3d  { return Vector3d{vec.X, vec.Z, vec.Z} }
+func (vec Vector3d) YXX() Vector3d  { return Vector3d{vec.Y, vec.X, vec.X} }
+func (vec Vector3d) YXY() Vector3d  { return Vector3d{vec.Y, vec.X, vec.Y} }
+func (vec Vector3d) YXZ() Vector3d  { return Vector3d{vec.Y, vec.X, vec.Z} }
+func (vec Vector3d) YYX() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.X} }
+func (vec Vector3d) YYY() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.Y} }
+func (vec Vector3d) YYZ() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.Z} }
+func (vec Vector3d) YZX() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.X} }
+func (vec Vector3d) YZY() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.Y} }
+func (vec Vector3d) YZZ() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.Z} }
+func (vec Vector3d) ZXX() Vector3d  { return Vector3d{vec.Z, vec.X, vec.X} }
+func (vec Vector3d) ZXY() Vector3d  { return Vector3d{vec.Z, vec.X, vec.Y} }
+func (vec Vector3d) ZXZ() Vector3d  { return Vector3d{vec.Z, vec.X, vec.Z} }
+func (vec Vector3d) ZYX() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.X} }
+func (vec Vector3d) ZYY() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.Y} }
+func (vec Vector3d) ZYZ() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.Z} }
+func (vec Vector3d) ZZX() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.X} }
+func (vec Vector3d) ZZY() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.Y} }
+func (vec Vector3d) ZZZ() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.Z} }
+func (vec Vector3d) XXXX() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.X} }
+func (vec Vector3d) XXXY() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.Y} }
+func (vec Vector3d) XXXZ() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.Z} }
+func (vec Vector3d) XXYX() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.X} }
+func (vec Vector3d) XXYY() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.Y} }
+func (vec Vector3d) XXYZ() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.Z} }
+func (vec Vector3d) XXZX() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.X} }
+func (vec Vector3d) XXZY() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.Y} }
+func (vec Vector3d) XXZZ() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.Z} }
+func (vec Vector3d) XYXX() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.X} }
+func (vec Vector3d) XYXY() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.Y} }
+func (vec Vector3d) XYXZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.Z} }
+func (vec Vector3d) XYYX() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.X} }
+func (vec Vector3d) XYYY() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.Y} }
+func (vec Vector3d) XYYZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.Z} }
+func (vec Vector3d) XYZX() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.X} }
+func (vec Vector3d) XYZY() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.Y} }
+func (vec Vector3d) XYZZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.Z} }
+func (vec Vector3d) XZXX() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.X} }
+func (vec Vector3d) XZXY() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.Y} }
+func (vec Vector3d) XZXZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.Z} }
+func (vec Vector3d) XZYX() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.X} }
+func (vec Vector3d) XZYY() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.Y} }
+func (vec Vector3d) XZYZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.Z} }
+func (vec Vector3d) XZZX() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.X} }
+func (vec Vector3d) XZZY() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.Y} }
+func (vec Vector3d) XZZZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.Z} }
+func (vec Vector3d) YXXX() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.X} }
+func (vec Vector3d) YXXY() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.Y} }
+func (vec Vector3d) YXXZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.Z} }
+func (vec Vector3d) YXYX() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.X} }
+func (vec Vector3d) YXYY() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.Y} }
+func (vec Vector3d) YXYZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.Z} }
+func (vec Vector3d) YXZX() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.X} }
+func (vec Vector3d) YXZY() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.Y} }
+func (vec Vector3d) YXZZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.Z} }
+func (vec Vector3d) YYXX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.X} }
+func (vec Vector3d) YYXY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.Y} }
+func (vec Vector3d) YYXZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.Z} }
+func (vec Vector3d) YYYX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.X} }
+func (vec Vector3d) YYYY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.Y} }
+func (vec Vector3d) YYYZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.Z} }
+func (vec Vector3d) YYZX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.X} }
+func (vec Vector3d) YYZY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.Y} }
+func (vec Vector3d) YYZZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.Z} }
+func (vec Vector3d) YZXX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.X} }
+func (vec Vector3d) YZXY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.Y} }
+func (vec Vector3d) YZXZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.Z} }
+func (vec Vector3d) YZYX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.X} }
+func (vec Vector3d) YZYY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.Y} }
+func (vec Vector3d) YZYZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.Z} }
+func (vec Vector3d) YZZX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.X} }
+func (vec Vector3d) YZZY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.Y} }
+func (vec Vector3d) YZZZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.Z} }
+func (vec Vector3d) ZXXX() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.X} }
+func (vec Vector3d) ZXXY() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.Y} }
+func (vec Vector3d) ZXXZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.Z} }
+func (vec Vector3d) ZXYX() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.X} }
+func (vec Vector3d) ZXYY() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.Y} }
+func (vec Vector3d) ZXYZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.Z} }
+func (vec Vector3d) ZXZX() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.X} }
+func (vec Vector3d) ZXZY() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.Y} }
+func (vec Vector3d) ZXZZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.Z} }
+func (vec Vector3d) ZYXX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.X} }
+func (vec Vector3d) ZYXY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.Y} }
+func (vec Vector3d) ZYXZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.Z} }
+func (vec Vector3d) ZYYX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.X} }
+func (vec Vector3d) ZYYY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.Y} }
+func (vec Vector3d) ZYYZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.Z} }
+func (vec Vector3d) ZYZX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.X} }
+func (vec Vector3d) ZYZY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.Y} }
+func (vec Vector3d) ZYZZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.Z} }
+func (vec Vector3d) ZZXX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.X} }
+func (vec Vector3d) ZZXY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.Y} }
+func (vec Vector3d) ZZXZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.Z} }
+func (vec Vector3d) ZZYX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.X} }
+func (vec Vector3d) ZZYY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.Y} }
+func (vec Vector3d) ZZYZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.Z} }
+func (vec Vector3d) ZZZX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.X} }
+func (vec Vector3d) ZZZY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.Y} }
+func (vec Vector3d) ZZZZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.Z} }
+func (vec Vector3d) X0() Vector2d   { return Vector2d{vec.X, 0} }
+func (vec Vector3d) X1() Vector2d   { return Vector2d{vec.X, 1} }
+func (vec Vector3d) Y0() Vector2d   { return Vector2d{vec.Y, 0} }
+func (vec Vector3d) Y1() Vector2d   { return Vector2d{vec.Y, 1} }
+func (vec Vector3d) Z0() Vector2d   { return Vector2d{vec.Z, 0} }
+func (vec Vector3d) Z1() Vector2d   { return Vector2d{vec.Z, 1} }
+func (vec Vector3d) XX0() Vector3d  { return Vector3d{vec.X, vec.X, 0} }
+func (vec Vector3d) XX1() Vector3d  { return Vector3d{vec.X, vec.X, 1} }
+func (vec Vector3d) XY0() Vector3d  { return Vector3d{vec.X, vec.Y, 0} }
+func (vec Vector3d) XY1() Vector3d  { return Vector3d{vec.X, vec.Y, 1} }
+func (vec Vector3d) XZ0() Vector3d  { return Vector3d{vec.X, vec.Z, 0} }
+func (vec Vector3d) XZ1() Vector3d  { return Vector3d{vec.X, vec.Z, 1} }
+func (vec Vector3d) YX0() Vector3d  { return Vector3d{vec.Y, vec.X, 0} }
+func (vec Vector3d) YX1() Vector3d  { return Vector3d{vec.Y, vec.X, 1} }
+func (vec Vector3d) YY0() Vector3d  { return Vector3d{vec.Y, vec.Y, 0} }
+func (vec Vector3d) YY1() Vector3d  { return Vector3d{vec.Y, vec.Y, 1} }
+func (vec Vector3d) YZ0() Vector3d  { return Vector3d{vec.Y, vec.Z, 0} }
+func (vec Vector3d) YZ1() Vector3d  { return Vector3d{vec.Y, vec.Z, 1} }
+func (vec Vector3d) ZX0() Vector3d  { return Vector3d{vec.Z, vec.X, 0} }
+func (vec Vector3d) ZX1() Vector3d  { return Vector3d{vec.Z, vec.X, 1} }
+func (vec Vector3d) ZY0() Vector3d  { return Vector3d{vec.Z, vec.Y, 0} }
+func (vec Vector3d) ZY1() Vector3d  { return Vector3d{vec.Z, vec.Y, 1} }
+func (vec Vector3d) ZZ0() Vector3d  { return Vector3d{vec.Z, vec.Z, 0} }
+func (vec Vector3d) ZZ1() Vector3d  { return Vector3d{vec.Z, vec.Z, 1} }
+func (vec Vector3d) XXX0() Vector4d { return Vector4d{vec.X, vec.X, vec.X, 0} }
+func (vec Vector3d) XXX1() Vector4d { return Vector4d{vec.X, vec.X, vec.X, 1} }
+func (vec Vector3d) XXY0() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, 0} }
+func (vec Vector3d) XXY1() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, 1} }
+func (vec Vector3d) XXZ0() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, 0} }
+func (vec Vector3d) XXZ1() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, 1} }
+func (vec Vector3d) XYX0() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, 0} }
+func (vec Vector3d) XYX1() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, 1} }
+func (vec Vector3d) XYY0() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, 0} }
+func (vec Vector3d) XYY1() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, 1} }
+func (vec Vector3d) XYZ0() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, 0} }
+func (vec Vector3d) XYZ1() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, 1} }
+func (vec Vector3d) XZX0() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, 0} }
+func (vec Vector3d) XZX1() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, 1} }
+func (vec Vector3d) XZY0() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, 0} }
+func (vec Vector3d) XZY1() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, 1} }
+func (vec Vector3d) XZZ0() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, 0} }
+func (vec Vector3d) XZZ1() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, 1} }
+func (vec Vector3d) YXX0() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, 0} }
+func (vec Vector3d) YXX1() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, 1} }
+func (vec Vector3d) YXY0() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, 0} }
+func (vec Vector3d) YXY1() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, 1} }
+func (vec Vector3d) YXZ0() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, 0} }
+func (vec Vector3d) YXZ1() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, 1} }
+func (vec Vector3d) YYX0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, 0} }
+func (vec Vector3d) YYX1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, 1} }
+func (vec Vector3d) YYY0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, 0} }
+func (vec Vector3d) YYY1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, 1} }
+func (vec Vector3d) YYZ0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, 0} }
+func (vec Vector3d) YYZ1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, 1} }
+func (vec Vector3d) YZX0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, 0} }
+func (vec Vector3d) YZX1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, 1} }
+func (vec Vector3d) YZY0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, 0} }
+func (vec Vector3d) YZY1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, 1} }
+func (vec Vector3d) YZZ0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, 0} }
+func (vec Vector3d) YZZ1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, 1} }
+func (vec Vector3d) ZXX0() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, 0} }
+func (vec Vector3d) ZXX1() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, 1} }
+func (vec Vector3d) ZXY0() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, 0} }
+func (vec Vector3d) ZXY1() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, 1} }
+func (vec Vector3d) ZXZ0() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, 0} }
+func (vec Vector3d) ZXZ1() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, 1} }
+func (vec Vector3d) ZYX0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, 0} }
+func (vec Vector3d) ZYX1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, 1} }
+func (vec Vector3d) ZYY0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, 0} }
+func (vec Vector3d) ZYY1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, 1} }
+func (vec Vector3d) ZYZ0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, 0} }
+func (vec Vector3d) ZYZ1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, 1} }
+func (vec Vector3d) ZZX0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, 0} }
+func (vec Vector3d) ZZX1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, 1} }
+func (vec Vector3d) ZZY0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, 0} }
+func (vec Vector3d) ZZY1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, 1} }
+func (vec Vector3d) ZZZ0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, 0} }
+func (vec Vector3d) ZZZ1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, 1} }
+
+// Swizzles of Vector4d
+
+func (vec Vector4d) XX() Vector2d   { return Vector2d{vec.X, vec.X} }
+func (vec Vector4d) XY() Vector2d   { return Vector2d{vec.X, vec.Y} }
+func (vec Vector4d) XZ() Vector2d   { return Vector2d{vec.X, vec.Z} }
+func (vec Vector4d) XW() Vector2d   { return Vector2d{vec.X, vec.W} }
+func (vec Vector4d) YX() Vector2d   { return Vector2d{vec.Y, vec.X} }
+func (vec Vector4d) YY() Vector2d   { return Vector2d{vec.Y, vec.Y} }
+func (vec Vector4d) YZ() Vector2d   { return Vector2d{vec.Y, vec.Z} }
+func (vec Vector4d) YW() Vector2d   { return Vector2d{vec.Y, vec.W} }
+func (vec Vector4d) ZX() Vector2d   { return Vector2d{vec.Z, vec.X} }
+func (vec Vector4d) ZY() Vector2d   { return Vector2d{vec.Z, vec.Y} }
+func (vec Vector4d) ZZ() Vector2d   { return Vector2d{vec.Z, vec.Z} }
+func (vec Vector4d) ZW() Vector2d   { return Vector2d{vec.Z, vec.W} }
+func (vec Vector4d) WX() Vector2d   { return Vector2d{vec.W, vec.X} }
+func (vec Vector4d) WY() Vector2d   { return Vector2d{vec.W, vec.Y} }
+func (vec Vector4d) WZ() Vector2d   { return Vector2d{vec.W, vec.Z} }
+func (vec Vector4d) WW() Vector2d   { return Vector2d{vec.W, vec.W} }
+func (vec Vector4d) XXX() Vector3d  { return Vector3d{vec.X, vec.X, vec.X} }
+func (vec Vector4d) XXY() Vector3d  { return Vector3d{vec.X, vec.X, vec.Y} }
+func (vec Vector4d) XXZ() Vector3d  { return Vector3d{vec.X, vec.X, vec.Z} }
+func (vec Vector4d) XXW() Vector3d  { return Vector3d{vec.X, vec.X, vec.W} }
+func (vec Vector4d) XYX() Vector3d  { return Vector3d{vec.X, vec.Y, vec.X} }
+func (vec Vector4d) XYY() Vector3d  { return Vector3d{vec.X, vec.Y, vec.Y} }
+func (vec Vector4d) XYZ() Vector3d  { return Vector3d{vec.X, vec.Y, vec.Z} }
+func (vec Vector4d) XYW() Vector3d  { return Vector3d{vec.X, vec.Y, vec.W} }
+func (vec Vector4d) XZX() Vector3d  { return Vector3d{vec.X, vec.Z, vec.X} }
+func (vec Vector4d) XZY() Vector3d  { return Vector3d{vec.X, vec.Z, vec.Y} }
+func (vec Vector4d) XZZ() Vector3d  { return Vector3d{vec.X, vec.Z, vec.Z} }
+func (vec Vector4d) XZW() Vector3d  { return Vector3d{vec.X, vec.Z, vec.W} }
+func (vec Vector4d) XWX() Vector3d  { return Vector3d{vec.X, vec.W, vec.X} }
+func (vec Vector4d) XWY() Vector3d  { return Vector3d{vec.X, vec.W, vec.Y} }
+func (vec Vector4d) XWZ() Vector3d  { return Vector3d{vec.X, vec.W, vec.Z} }
+func (vec Vector4d) XWW() Vector3d  { return Vector3d{vec.X, vec.W, vec.W} }
+func (vec Vector4d) YXX() Vector3d  { return Vector3d{vec.Y, vec.X, vec.X} }
+func (vec Vector4d) YXY() Vector3d  { return Vector3d{vec.Y, vec.X, vec.Y} }
+func (vec Vector4d) YXZ() Vector3d  { return Vector3d{vec.Y, vec.X, vec.Z} }
+func (vec Vector4d) YXW() Vector3d  { return Vector3d{vec.Y, vec.X, vec.W} }
+func (vec Vector4d) YYX() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.X} }
+func (vec Vector4d) YYY() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.Y} }
+func (vec Vector4d) YYZ() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.Z} }
+func (vec Vector4d) YYW() Vector3d  { return Vector3d{vec.Y, vec.Y, vec.W} }
+func (vec Vector4d) YZX() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.X} }
+func (vec Vector4d) YZY() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.Y} }
+func (vec Vector4d) YZZ() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.Z} }
+func (vec Vector4d) YZW() Vector3d  { return Vector3d{vec.Y, vec.Z, vec.W} }
+func (vec Vector4d) YWX() Vector3d  { return Vector3d{vec.Y, vec.W, vec.X} }
+func (vec Vector4d) YWY() Vector3d  { return Vector3d{vec.Y, vec.W, vec.Y} }
+func (vec Vector4d) YWZ() Vector3d  { return Vector3d{vec.Y, vec.W, vec.Z} }
+func (vec Vector4d) YWW() Vector3d  { return Vector3d{vec.Y, vec.W, vec.W} }
+func (vec Vector4d) ZXX() Vector3d  { return Vector3d{vec.Z, vec.X, vec.X} }
+func (vec Vector4d) ZXY() Vector3d  { return Vector3d{vec.Z, vec.X, vec.Y} }
+func (vec Vector4d) ZXZ() Vector3d  { return Vector3d{vec.Z, vec.X, vec.Z} }
+func (vec Vector4d) ZXW() Vector3d  { return Vector3d{vec.Z, vec.X, vec.W} }
+func (vec Vector4d) ZYX() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.X} }
+func (vec Vector4d) ZYY() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.Y} }
+func (vec Vector4d) ZYZ() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.Z} }
+func (vec Vector4d) ZYW() Vector3d  { return Vector3d{vec.Z, vec.Y, vec.W} }
+func (vec Vector4d) ZZX() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.X} }
+func (vec Vector4d) ZZY() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.Y} }
+func (vec Vector4d) ZZZ() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.Z} }
+func (vec Vector4d) ZZW() Vector3d  { return Vector3d{vec.Z, vec.Z, vec.W} }
+func (vec Vector4d) ZWX() Vector3d  { return Vector3d{vec.Z, vec.W, vec.X} }
+func (vec Vector4d) ZWY() Vector3d  { return Vector3d{vec.Z, vec.W, vec.Y} }
+func (vec Vector4d) ZWZ() Vector3d  { return Vector3d{vec.Z, vec.W, vec.Z} }
+func (vec Vector4d) ZWW() Vector3d  { return Vector3d{vec.Z, vec.W, vec.W} }
+func (vec Vector4d) WXX() Vector3d  { return Vector3d{vec.W, vec.X, vec.X} }
+func (vec Vector4d) WXY() Vector3d  { return Vector3d{vec.W, vec.X, vec.Y} }
+func (vec Vector4d) WXZ() Vector3d  { return Vector3d{vec.W, vec.X, vec.Z} }
+func (vec Vector4d) WXW() Vector3d  { return Vector3d{vec.W, vec.X, vec.W} }
+func (vec Vector4d) WYX() Vector3d  { return Vector3d{vec.W, vec.Y, vec.X} }
+func (vec Vector4d) WYY() Vector3d  { return Vector3d{vec.W, vec.Y, vec.Y} }
+func (vec Vector4d) WYZ() Vector3d  { return Vector3d{vec.W, vec.Y, vec.Z} }
+func (vec Vector4d) WYW() Vector3d  { return Vector3d{vec.W, vec.Y, vec.W} }
+func (vec Vector4d) WZX() Vector3d  { return Vector3d{vec.W, vec.Z, vec.X} }
+func (vec Vector4d) WZY() Vector3d  { return Vector3d{vec.W, vec.Z, vec.Y} }
+func (vec Vector4d) WZZ() Vector3d  { return Vector3d{vec.W, vec.Z, vec.Z} }
+func (vec Vector4d) WZW() Vector3d  { return Vector3d{vec.W, vec.Z, vec.W} }
+func (vec Vector4d) WWX() Vector3d  { return Vector3d{vec.W, vec.W, vec.X} }
+func (vec Vector4d) WWY() Vector3d  { return Vector3d{vec.W, vec.W, vec.Y} }
+func (vec Vector4d) WWZ() Vector3d  { return Vector3d{vec.W, vec.W, vec.Z} }
+func (vec Vector4d) WWW() Vector3d  { return Vector3d{vec.W, vec.W, vec.W} }
+func (vec Vector4d) XXXX() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.X} }
+func (vec Vector4d) XXXY() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.Y} }
+func (vec Vector4d) XXXZ() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.Z} }
+func (vec Vector4d) XXXW() Vector4d { return Vector4d{vec.X, vec.X, vec.X, vec.W} }
+func (vec Vector4d) XXYX() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.X} }
+func (vec Vector4d) XXYY() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.Y} }
+func (vec Vector4d) XXYZ() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.Z} }
+func (vec Vector4d) XXYW() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, vec.W} }
+func (vec Vector4d) XXZX() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.X} }
+func (vec Vector4d) XXZY() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.Y} }
+func (vec Vector4d) XXZZ() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.Z} }
+func (vec Vector4d) XXZW() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, vec.W} }
+func (vec Vector4d) XXWX() Vector4d { return Vector4d{vec.X, vec.X, vec.W, vec.X} }
+func (vec Vector4d) XXWY() Vector4d { return Vector4d{vec.X, vec.X, vec.W, vec.Y} }
+func (vec Vector4d) XXWZ() Vector4d { return Vector4d{vec.X, vec.X, vec.W, vec.Z} }
+func (vec Vector4d) XXWW() Vector4d { return Vector4d{vec.X, vec.X, vec.W, vec.W} }
+func (vec Vector4d) XYXX() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.X} }
+func (vec Vector4d) XYXY() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.Y} }
+func (vec Vector4d) XYXZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.Z} }
+func (vec Vector4d) XYXW() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, vec.W} }
+func (vec Vector4d) XYYX() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.X} }
+func (vec Vector4d) XYYY() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4d) XYYZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4d) XYYW() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, vec.W} }
+func (vec Vector4d) XYZX() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.X} }
+func (vec Vector4d) XYZY() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4d) XYZZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4d) XYZW() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, vec.W} }
+func (vec Vector4d) XYWX() Vector4d { return Vector4d{vec.X, vec.Y, vec.W, vec.X} }
+func (vec Vector4d) XYWY() Vector4d { return Vector4d{vec.X, vec.Y, vec.W, vec.Y} }
+func (vec Vector4d) XYWZ() Vector4d { return Vector4d{vec.X, vec.Y, vec.W, vec.Z} }
+func (vec Vector4d) XYWW() Vector4d { return Vector4d{vec.X, vec.Y, vec.W, vec.W} }
+func (vec Vector4d) XZXX() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.X} }
+func (vec Vector4d) XZXY() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.Y} }
+func (vec Vector4d) XZXZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.Z} }
+func (vec Vector4d) XZXW() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, vec.W} }
+func (vec Vector4d) XZYX() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.X} }
+func (vec Vector4d) XZYY() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4d) XZYZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4d) XZYW() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, vec.W} }
+func (vec Vector4d) XZZX() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.X} }
+func (vec Vector4d) XZZY() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4d) XZZZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4d) XZZW() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, vec.W} }
+func (vec Vector4d) XZWX() Vector4d { return Vector4d{vec.X, vec.Z, vec.W, vec.X} }
+func (vec Vector4d) XZWY() Vector4d { return Vector4d{vec.X, vec.Z, vec.W, vec.Y} }
+func (vec Vector4d) XZWZ() Vector4d { return Vector4d{vec.X, vec.Z, vec.W, vec.Z} }
+func (vec Vector4d) XZWW() Vector4d { return Vector4d{vec.X, vec.Z, vec.W, vec.W} }
+func (vec Vector4d) XWXX() Vector4d { return Vector4d{vec.X, vec.W, vec.X, vec.X} }
+func (vec Vector4d) XWXY() Vector4d { return Vector4d{vec.X, vec.W, vec.X, vec.Y} }
+func (vec Vector4d) XWXZ() Vector4d { return Vector4d{vec.X, vec.W, vec.X, vec.Z} }
+func (vec Vector4d) XWXW() Vector4d { return Vector4d{vec.X, vec.W, vec.X, vec.W} }
+func (vec Vector4d) XWYX() Vector4d { return Vector4d{vec.X, vec.W, vec.Y, vec.X} }
+func (vec Vector4d) XWYY() Vector4d { return Vector4d{vec.X, vec.W, vec.Y, vec.Y} }
+func (vec Vector4d) XWYZ() Vector4d { return Vector4d{vec.X, vec.W, vec.Y, vec.Z} }
+func (vec Vector4d) XWYW() Vector4d { return Vector4d{vec.X, vec.W, vec.Y, vec.W} }
+func (vec Vector4d) XWZX() Vector4d { return Vector4d{vec.X, vec.W, vec.Z, vec.X} }
+func (vec Vector4d) XWZY() Vector4d { return Vector4d{vec.X, vec.W, vec.Z, vec.Y} }
+func (vec Vector4d) XWZZ() Vector4d { return Vector4d{vec.X, vec.W, vec.Z, vec.Z} }
+func (vec Vector4d) XWZW() Vector4d { return Vector4d{vec.X, vec.W, vec.Z, vec.W} }
+func (vec Vector4d) XWWX() Vector4d { return Vector4d{vec.X, vec.W, vec.W, vec.X} }
+func (vec Vector4d) XWWY() Vector4d { return Vector4d{vec.X, vec.W, vec.W, vec.Y} }
+func (vec Vector4d) XWWZ() Vector4d { return Vector4d{vec.X, vec.W, vec.W, vec.Z} }
+func (vec Vector4d) XWWW() Vector4d { return Vector4d{vec.X, vec.W, vec.W, vec.W} }
+func (vec Vector4d) YXXX() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.X} }
+func (vec Vector4d) YXXY() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.Y} }
+func (vec Vector4d) YXXZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.Z} }
+func (vec Vector4d) YXXW() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, vec.W} }
+func (vec Vector4d) YXYX() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.X} }
+func (vec Vector4d) YXYY() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.Y} }
+func (vec Vector4d) YXYZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.Z} }
+func (vec Vector4d) YXYW() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, vec.W} }
+func (vec Vector4d) YXZX() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.X} }
+func (vec Vector4d) YXZY() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.Y} }
+func (vec Vector4d) YXZZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.Z} }
+func (vec Vector4d) YXZW() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, vec.W} }
+func (vec Vector4d) YXWX() Vector4d { return Vector4d{vec.Y, vec.X, vec.W, vec.X} }
+func (vec Vector4d) YXWY() Vector4d { return Vector4d{vec.Y, vec.X, vec.W, vec.Y} }
+func (vec Vector4d) YXWZ() Vector4d { return Vector4d{vec.Y, vec.X, vec.W, vec.Z} }
+func (vec Vector4d) YXWW() Vector4d { return Vector4d{vec.Y, vec.X, vec.W, vec.W} }
+func (vec Vector4d) YYXX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.X} }
+func (vec Vector4d) YYXY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.Y} }
+func (vec Vector4d) YYXZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.Z} }
+func (vec Vector4d) YYXW() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, vec.W} }
+func (vec Vector4d) YYYX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.X} }
+func (vec Vector4d) YYYY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4d) YYYZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4d) YYYW() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, vec.W} }
+func (vec Vector4d) YYZX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.X} }
+func (vec Vector4d) YYZY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4d) YYZZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4d) YYZW() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, vec.W} }
+func (vec Vector4d) YYWX() Vector4d { return Vector4d{vec.Y, vec.Y, vec.W, vec.X} }
+func (vec Vector4d) YYWY() Vector4d { return Vector4d{vec.Y, vec.Y, vec.W, vec.Y} }
+func (vec Vector4d) YYWZ() Vector4d { return Vector4d{vec.Y, vec.Y, vec.W, vec.Z} }
+func (vec Vector4d) YYWW() Vector4d { return Vector4d{vec.Y, vec.Y, vec.W, vec.W} }
+func (vec Vector4d) YZXX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.X} }
+func (vec Vector4d) YZXY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.Y} }
+func (vec Vector4d) YZXZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.Z} }
+func (vec Vector4d) YZXW() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, vec.W} }
+func (vec Vector4d) YZYX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.X} }
+func (vec Vector4d) YZYY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4d) YZYZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4d) YZYW() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, vec.W} }
+func (vec Vector4d) YZZX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.X} }
+func (vec Vector4d) YZZY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4d) YZZZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4d) YZZW() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, vec.W} }
+func (vec Vector4d) YZWX() Vector4d { return Vector4d{vec.Y, vec.Z, vec.W, vec.X} }
+func (vec Vector4d) YZWY() Vector4d { return Vector4d{vec.Y, vec.Z, vec.W, vec.Y} }
+func (vec Vector4d) YZWZ() Vector4d { return Vector4d{vec.Y, vec.Z, vec.W, vec.Z} }
+func (vec Vector4d) YZWW() Vector4d { return Vector4d{vec.Y, vec.Z, vec.W, vec.W} }
+func (vec Vector4d) YWXX() Vector4d { return Vector4d{vec.Y, vec.W, vec.X, vec.X} }
+func (vec Vector4d) YWXY() Vector4d { return Vector4d{vec.Y, vec.W, vec.X, vec.Y} }
+func (vec Vector4d) YWXZ() Vector4d { return Vector4d{vec.Y, vec.W, vec.X, vec.Z} }
+func (vec Vector4d) YWXW() Vector4d { return Vector4d{vec.Y, vec.W, vec.X, vec.W} }
+func (vec Vector4d) YWYX() Vector4d { return Vector4d{vec.Y, vec.W, vec.Y, vec.X} }
+func (vec Vector4d) YWYY() Vector4d { return Vector4d{vec.Y, vec.W, vec.Y, vec.Y} }
+func (vec Vector4d) YWYZ() Vector4d { return Vector4d{vec.Y, vec.W, vec.Y, vec.Z} }
+func (vec Vector4d) YWYW() Vector4d { return Vector4d{vec.Y, vec.W, vec.Y, vec.W} }
+func (vec Vector4d) YWZX() Vector4d { return Vector4d{vec.Y, vec.W, vec.Z, vec.X} }
+func (vec Vector4d) YWZY() Vector4d { return Vector4d{vec.Y, vec.W, vec.Z, vec.Y} }
+func (vec Vector4d) YWZZ() Vector4d { return Vector4d{vec.Y, vec.W, vec.Z, vec.Z} }
+func (vec Vector4d) YWZW() Vector4d { return Vector4d{vec.Y, vec.W, vec.Z, vec.W} }
+func (vec Vector4d) YWWX() Vector4d { return Vector4d{vec.Y, vec.W, vec.W, vec.X} }
+func (vec Vector4d) YWWY() Vector4d { return Vector4d{vec.Y, vec.W, vec.W, vec.Y} }
+func (vec Vector4d) YWWZ() Vector4d { return Vector4d{vec.Y, vec.W, vec.W, vec.Z} }
+func (vec Vector4d) YWWW() Vector4d { return Vector4d{vec.Y, vec.W, vec.W, vec.W} }
+func (vec Vector4d) ZXXX() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.X} }
+func (vec Vector4d) ZXXY() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.Y} }
+func (vec Vector4d) ZXXZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.Z} }
+func (vec Vector4d) ZXXW() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, vec.W} }
+func (vec Vector4d) ZXYX() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.X} }
+func (vec Vector4d) ZXYY() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.Y} }
+func (vec Vector4d) ZXYZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.Z} }
+func (vec Vector4d) ZXYW() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, vec.W} }
+func (vec Vector4d) ZXZX() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.X} }
+func (vec Vector4d) ZXZY() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.Y} }
+func (vec Vector4d) ZXZZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.Z} }
+func (vec Vector4d) ZXZW() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, vec.W} }
+func (vec Vector4d) ZXWX() Vector4d { return Vector4d{vec.Z, vec.X, vec.W, vec.X} }
+func (vec Vector4d) ZXWY() Vector4d { return Vector4d{vec.Z, vec.X, vec.W, vec.Y} }
+func (vec Vector4d) ZXWZ() Vector4d { return Vector4d{vec.Z, vec.X, vec.W, vec.Z} }
+func (vec Vector4d) ZXWW() Vector4d { return Vector4d{vec.Z, vec.X, vec.W, vec.W} }
+func (vec Vector4d) ZYXX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.X} }
+func (vec Vector4d) ZYXY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.Y} }
+func (vec Vector4d) ZYXZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.Z} }
+func (vec Vector4d) ZYXW() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, vec.W} }
+func (vec Vector4d) ZYYX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.X} }
+func (vec Vector4d) ZYYY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4d) ZYYZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4d) ZYYW() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, vec.W} }
+func (vec Vector4d) ZYZX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.X} }
+func (vec Vector4d) ZYZY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4d) ZYZZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4d) ZYZW() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, vec.W} }
+func (vec Vector4d) ZYWX() Vector4d { return Vector4d{vec.Z, vec.Y, vec.W, vec.X} }
+func (vec Vector4d) ZYWY() Vector4d { return Vector4d{vec.Z, vec.Y, vec.W, vec.Y} }
+func (vec Vector4d) ZYWZ() Vector4d { return Vector4d{vec.Z, vec.Y, vec.W, vec.Z} }
+func (vec Vector4d) ZYWW() Vector4d { return Vector4d{vec.Z, vec.Y, vec.W, vec.W} }
+func (vec Vector4d) ZZXX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.X} }
+func (vec Vector4d) ZZXY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.Y} }
+func (vec Vector4d) ZZXZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.Z} }
+func (vec Vector4d) ZZXW() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, vec.W} }
+func (vec Vector4d) ZZYX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.X} }
+func (vec Vector4d) ZZYY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4d) ZZYZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4d) ZZYW() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, vec.W} }
+func (vec Vector4d) ZZZX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.X} }
+func (vec Vector4d) ZZZY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4d) ZZZZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4d) ZZZW() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, vec.W} }
+func (vec Vector4d) ZZWX() Vector4d { return Vector4d{vec.Z, vec.Z, vec.W, vec.X} }
+func (vec Vector4d) ZZWY() Vector4d { return Vector4d{vec.Z, vec.Z, vec.W, vec.Y} }
+func (vec Vector4d) ZZWZ() Vector4d { return Vector4d{vec.Z, vec.Z, vec.W, vec.Z} }
+func (vec Vector4d) ZZWW() Vector4d { return Vector4d{vec.Z, vec.Z, vec.W, vec.W} }
+func (vec Vector4d) ZWXX() Vector4d { return Vector4d{vec.Z, vec.W, vec.X, vec.X} }
+func (vec Vector4d) ZWXY() Vector4d { return Vector4d{vec.Z, vec.W, vec.X, vec.Y} }
+func (vec Vector4d) ZWXZ() Vector4d { return Vector4d{vec.Z, vec.W, vec.X, vec.Z} }
+func (vec Vector4d) ZWXW() Vector4d { return Vector4d{vec.Z, vec.W, vec.X, vec.W} }
+func (vec Vector4d) ZWYX() Vector4d { return Vector4d{vec.Z, vec.W, vec.Y, vec.X} }
+func (vec Vector4d) ZWYY() Vector4d { return Vector4d{vec.Z, vec.W, vec.Y, vec.Y} }
+func (vec Vector4d) ZWYZ() Vector4d { return Vector4d{vec.Z, vec.W, vec.Y, vec.Z} }
+func (vec Vector4d) ZWYW() Vector4d { return Vector4d{vec.Z, vec.W, vec.Y, vec.W} }
+func (vec Vector4d) ZWZX() Vector4d { return Vector4d{vec.Z, vec.W, vec.Z, vec.X} }
+func (vec Vector4d) ZWZY() Vector4d { return Vector4d{vec.Z, vec.W, vec.Z, vec.Y} }
+func (vec Vector4d) ZWZZ() Vector4d { return Vector4d{vec.Z, vec.W, vec.Z, vec.Z} }
+func (vec Vector4d) ZWZW() Vector4d { return Vector4d{vec.Z, vec.W, vec.Z, vec.W} }
+func (vec Vector4d) ZWWX() Vector4d { return Vector4d{vec.Z, vec.W, vec.W, vec.X} }
+func (vec Vector4d) ZWWY() Vector4d { return Vector4d{vec.Z, vec.W, vec.W, vec.Y} }
+func (vec Vector4d) ZWWZ() Vector4d { return Vector4d{vec.Z, vec.W, vec.W, vec.Z} }
+func (vec Vector4d) ZWWW() Vector4d { return Vector4d{vec.Z, vec.W, vec.W, vec.W} }
+func (vec Vector4d) WXXX() Vector4d { return Vector4d{vec.W, vec.X, vec.X, vec.X} }
+func (vec Vector4d) WXXY() Vector4d { return Vector4d{vec.W, vec.X, vec.X, vec.Y} }
+func (vec Vector4d) WXXZ() Vector4d { return Vector4d{vec.W, vec.X, vec.X, vec.Z} }
+func (vec Vector4d) WXXW() Vector4d { return Vector4d{vec.W, vec.X, vec.X, vec.W} }
+func (vec Vector4d) WXYX() Vector4d { return Vector4d{vec.W, vec.X, vec.Y, vec.X} }
+func (vec Vector4d) WXYY() Vector4d { return Vector4d{vec.W, vec.X, vec.Y, vec.Y} }
+func (vec Vector4d) WXYZ() Vector4d { return Vector4d{vec.W, vec.X, vec.Y, vec.Z} }
+func (vec Vector4d) WXYW() Vector4d { return Vector4d{vec.W, vec.X, vec.Y, vec.W} }
+func (vec Vector4d) WXZX() Vector4d { return Vector4d{vec.W, vec.X, vec.Z, vec.X} }
+func (vec Vector4d) WXZY() Vector4d { return Vector4d{vec.W, vec.X, vec.Z, vec.Y} }
+func (vec Vector4d) WXZZ() Vector4d { return Vector4d{vec.W, vec.X, vec.Z, vec.Z} }
+func (vec Vector4d) WXZW() Vector4d { return Vector4d{vec.W, vec.X, vec.Z, vec.W} }
+func (vec Vector4d) WXWX() Vector4d { return Vector4d{vec.W, vec.X, vec.W, vec.X} }
+func (vec Vector4d) WXWY() Vector4d { return Vector4d{vec.W, vec.X, vec.W, vec.Y} }
+func (vec Vector4d) WXWZ() Vector4d { return Vector4d{vec.W, vec.X, vec.W, vec.Z} }
+func (vec Vector4d) WXWW() Vector4d { return Vector4d{vec.W, vec.X, vec.W, vec.W} }
+func (vec Vector4d) WYXX() Vector4d { return Vector4d{vec.W, vec.Y, vec.X, vec.X} }
+func (vec Vector4d) WYXY() Vector4d { return Vector4d{vec.W, vec.Y, vec.X, vec.Y} }
+func (vec Vector4d) WYXZ() Vector4d { return Vector4d{vec.W, vec.Y, vec.X, vec.Z} }
+func (vec Vector4d) WYXW() Vector4d { return Vector4d{vec.W, vec.Y, vec.X, vec.W} }
+func (vec Vector4d) WYYX() Vector4d { return Vector4d{vec.W, vec.Y, vec.Y, vec.X} }
+func (vec Vector4d) WYYY() Vector4d { return Vector4d{vec.W, vec.Y, vec.Y, vec.Y} }
+func (vec Vector4d) WYYZ() Vector4d { return Vector4d{vec.W, vec.Y, vec.Y, vec.Z} }
+func (vec Vector4d) WYYW() Vector4d { return Vector4d{vec.W, vec.Y, vec.Y, vec.W} }
+func (vec Vector4d) WYZX() Vector4d { return Vector4d{vec.W, vec.Y, vec.Z, vec.X} }
+func (vec Vector4d) WYZY() Vector4d { return Vector4d{vec.W, vec.Y, vec.Z, vec.Y} }
+func (vec Vector4d) WYZZ() Vector4d { return Vector4d{vec.W, vec.Y, vec.Z, vec.Z} }
+func (vec Vector4d) WYZW() Vector4d { return Vector4d{vec.W, vec.Y, vec.Z, vec.W} }
+func (vec Vector4d) WYWX() Vector4d { return Vector4d{vec.W, vec.Y, vec.W, vec.X} }
+func (vec Vector4d) WYWY() Vector4d { return Vector4d{vec.W, vec.Y, vec.W, vec.Y} }
+func (vec Vector4d) WYWZ() Vector4d { return Vector4d{vec.W, vec.Y, vec.W, vec.Z} }
+func (vec Vector4d) WYWW() Vector4d { return Vector4d{vec.W, vec.Y, vec.W, vec.W} }
+func (vec Vector4d) WZXX() Vector4d { return Vector4d{vec.W, vec.Z, vec.X, vec.X} }
+func (vec Vector4d) WZXY() Vector4d { return Vector4d{vec.W, vec.Z, vec.X, vec.Y} }
+func (vec Vector4d) WZXZ() Vector4d { return Vector4d{vec.W, vec.Z, vec.X, vec.Z} }
+func (vec Vector4d) WZXW() Vector4d { return Vector4d{vec.W, vec.Z, vec.X, vec.W} }
+func (vec Vector4d) WZYX() Vector4d { return Vector4d{vec.W, vec.Z, vec.Y, vec.X} }
+func (vec Vector4d) WZYY() Vector4d { return Vector4d{vec.W, vec.Z, vec.Y, vec.Y} }
+func (vec Vector4d) WZYZ() Vector4d { return Vector4d{vec.W, vec.Z, vec.Y, vec.Z} }
+func (vec Vector4d) WZYW() Vector4d { return Vector4d{vec.W, vec.Z, vec.Y, vec.W} }
+func (vec Vector4d) WZZX() Vector4d { return Vector4d{vec.W, vec.Z, vec.Z, vec.X} }
+func (vec Vector4d) WZZY() Vector4d { return Vector4d{vec.W, vec.Z, vec.Z, vec.Y} }
+func (vec Vector4d) WZZZ() Vector4d { return Vector4d{vec.W, vec.Z, vec.Z, vec.Z} }
+func (vec Vector4d) WZZW() Vector4d { return Vector4d{vec.W, vec.Z, vec.Z, vec.W} }
+func (vec Vector4d) WZWX() Vector4d { return Vector4d{vec.W, vec.Z, vec.W, vec.X} }
+func (vec Vector4d) WZWY() Vector4d { return Vector4d{vec.W, vec.Z, vec.W, vec.Y} }
+func (vec Vector4d) WZWZ() Vector4d { return Vector4d{vec.W, vec.Z, vec.W, vec.Z} }
+func (vec Vector4d) WZWW() Vector4d { return Vector4d{vec.W, vec.Z, vec.W, vec.W} }
+func (vec Vector4d) WWXX() Vector4d { return Vector4d{vec.W, vec.W, vec.X, vec.X} }
+func (vec Vector4d) WWXY() Vector4d { return Vector4d{vec.W, vec.W, vec.X, vec.Y} }
+func (vec Vector4d) WWXZ() Vector4d { return Vector4d{vec.W, vec.W, vec.X, vec.Z} }
+func (vec Vector4d) WWXW() Vector4d { return Vector4d{vec.W, vec.W, vec.X, vec.W} }
+func (vec Vector4d) WWYX() Vector4d { return Vector4d{vec.W, vec.W, vec.Y, vec.X} }
+func (vec Vector4d) WWYY() Vector4d { return Vector4d{vec.W, vec.W, vec.Y, vec.Y} }
+func (vec Vector4d) WWYZ() Vector4d { return Vector4d{vec.W, vec.W, vec.Y, vec.Z} }
+func (vec Vector4d) WWYW() Vector4d { return Vector4d{vec.W, vec.W, vec.Y, vec.W} }
+func (vec Vector4d) WWZX() Vector4d { return Vector4d{vec.W, vec.W, vec.Z, vec.X} }
+func (vec Vector4d) WWZY() Vector4d { return Vector4d{vec.W, vec.W, vec.Z, vec.Y} }
+func (vec Vector4d) WWZZ() Vector4d { return Vector4d{vec.W, vec.W, vec.Z, vec.Z} }
+func (vec Vector4d) WWZW() Vector4d { return Vector4d{vec.W, vec.W, vec.Z, vec.W} }
+func (vec Vector4d) WWWX() Vector4d { return Vector4d{vec.W, vec.W, vec.W, vec.X} }
+func (vec Vector4d) WWWY() Vector4d { return Vector4d{vec.W, vec.W, vec.W, vec.Y} }
+func (vec Vector4d) WWWZ() Vector4d { return Vector4d{vec.W, vec.W, vec.W, vec.Z} }
+func (vec Vector4d) WWWW() Vector4d { return Vector4d{vec.W, vec.W, vec.W, vec.W} }
+func (vec Vector4d) X0() Vector2d   { return Vector2d{vec.X, 0} }
+func (vec Vector4d) X1() Vector2d   { return Vector2d{vec.X, 1} }
+func (vec Vector4d) Y0() Vector2d   { return Vector2d{vec.Y, 0} }
+func (vec Vector4d) Y1() Vector2d   { return Vector2d{vec.Y, 1} }
+func (vec Vector4d) Z0() Vector2d   { return Vector2d{vec.Z, 0} }
+func (vec Vector4d) Z1() Vector2d   { return Vector2d{vec.Z, 1} }
+func (vec Vector4d) W0() Vector2d   { return Vector2d{vec.W, 0} }
+func (vec Vector4d) W1() Vector2d   { return Vector2d{vec.W, 1} }
+func (vec Vector4d) XX0() Vector3d  { return Vector3d{vec.X, vec.X, 0} }
+func (vec Vector4d) XX1() Vector3d  { return Vector3d{vec.X, vec.X, 1} }
+func (vec Vector4d) XY0() Vector3d  { return Vector3d{vec.X, vec.Y, 0} }
+func (vec Vector4d) XY1() Vector3d  { return Vector3d{vec.X, vec.Y, 1} }
+func (vec Vector4d) XZ0() Vector3d  { return Vector3d{vec.X, vec.Z, 0} }
+func (vec Vector4d) XZ1() Vector3d  { return Vector3d{vec.X, vec.Z, 1} }
+func (vec Vector4d) XW0() Vector3d  { return Vector3d{vec.X, vec.W, 0} }
+func (vec Vector4d) XW1() Vector3d  { return Vector3d{vec.X, vec.W, 1} }
+func (vec Vector4d) YX0() Vector3d  { return Vector3d{vec.Y, vec.X, 0} }
+func (vec Vector4d) YX1() Vector3d  { return Vector3d{vec.Y, vec.X, 1} }
+func (vec Vector4d) YY0() Vector3d  { return Vector3d{vec.Y, vec.Y, 0} }
+func (vec Vector4d) YY1() Vector3d  { return Vector3d{vec.Y, vec.Y, 1} }
+func (vec Vector4d) YZ0() Vector3d  { return Vector3d{vec.Y, vec.Z, 0} }
+func (vec Vector4d) YZ1() Vector3d  { return Vector3d{vec.Y, vec.Z, 1} }
+func (vec Vector4d) YW0() Vector3d  { return Vector3d{vec.Y, vec.W, 0} }
+func (vec Vector4d) YW1() Vector3d  { return Vector3d{vec.Y, vec.W, 1} }
+func (vec Vector4d) ZX0() Vector3d  { return Vector3d{vec.Z, vec.X, 0} }
+func (vec Vector4d) ZX1() Vector3d  { return Vector3d{vec.Z, vec.X, 1} }
+func (vec Vector4d) ZY0() Vector3d  { return Vector3d{vec.Z, vec.Y, 0} }
+func (vec Vector4d) ZY1() Vector3d  { return Vector3d{vec.Z, vec.Y, 1} }
+func (vec Vector4d) ZZ0() Vector3d  { return Vector3d{vec.Z, vec.Z, 0} }
+func (vec Vector4d) ZZ1() Vector3d  { return Vector3d{vec.Z, vec.Z, 1} }
+func (vec Vector4d) ZW0() Vector3d  { return Vector3d{vec.Z, vec.W, 0} }
+func (vec Vector4d) ZW1() Vector3d  { return Vector3d{vec.Z, vec.W, 1} }
+func (vec Vector4d) WX0() Vector3d  { return Vector3d{vec.W, vec.X, 0} }
+func (vec Vector4d) WX1() Vector3d  { return Vector3d{vec.W, vec.X, 1} }
+func (vec Vector4d) WY0() Vector3d  { return Vector3d{vec.W, vec.Y, 0} }
+func (vec Vector4d) WY1() Vector3d  { return Vector3d{vec.W, vec.Y, 1} }
+func (vec Vector4d) WZ0() Vector3d  { return Vector3d{vec.W, vec.Z, 0} }
+func (vec Vector4d) WZ1() Vector3d  { return Vector3d{vec.W, vec.Z, 1} }
+func (vec Vector4d) WW0() Vector3d  { return Vector3d{vec.W, vec.W, 0} }
+func (vec Vector4d) WW1() Vector3d  { return Vector3d{vec.W, vec.W, 1} }
+func (vec Vector4d) XXX0() Vector4d { return Vector4d{vec.X, vec.X, vec.X, 0} }
+func (vec Vector4d) XXX1() Vector4d { return Vector4d{vec.X, vec.X, vec.X, 1} }
+func (vec Vector4d) XXY0() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, 0} }
+func (vec Vector4d) XXY1() Vector4d { return Vector4d{vec.X, vec.X, vec.Y, 1} }
+func (vec Vector4d) XXZ0() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, 0} }
+func (vec Vector4d) XXZ1() Vector4d { return Vector4d{vec.X, vec.X, vec.Z, 1} }
+func (vec Vector4d) XXW0() Vector4d { return Vector4d{vec.X, vec.X, vec.W, 0} }
+func (vec Vector4d) XXW1() Vector4d { return Vector4d{vec.X, vec.X, vec.W, 1} }
+func (vec Vector4d) XYX0() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, 0} }
+func (vec Vector4d) XYX1() Vector4d { return Vector4d{vec.X, vec.Y, vec.X, 1} }
+func (vec Vector4d) XYY0() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, 0} }
+func (vec Vector4d) XYY1() Vector4d { return Vector4d{vec.X, vec.Y, vec.Y, 1} }
+func (vec Vector4d) XYZ0() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, 0} }
+func (vec Vector4d) XYZ1() Vector4d { return Vector4d{vec.X, vec.Y, vec.Z, 1} }
+func (vec Vector4d) XYW0() Vector4d { return Vector4d{vec.X, vec.Y, vec.W, 0} }
+func (vec Vector4d) XYW1() Vector4d { return Vector4d{vec.X, vec.Y, vec.W, 1} }
+func (vec Vector4d) XZX0() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, 0} }
+func (vec Vector4d) XZX1() Vector4d { return Vector4d{vec.X, vec.Z, vec.X, 1} }
+func (vec Vector4d) XZY0() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, 0} }
+func (vec Vector4d) XZY1() Vector4d { return Vector4d{vec.X, vec.Z, vec.Y, 1} }
+func (vec Vector4d) XZZ0() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, 0} }
+func (vec Vector4d) XZZ1() Vector4d { return Vector4d{vec.X, vec.Z, vec.Z, 1} }
+func (vec Vector4d) XZW0() Vector4d { return Vector4d{vec.X, vec.Z, vec.W, 0} }
+func (vec Vector4d) XZW1() Vector4d { return Vector4d{vec.X, vec.Z, vec.W, 1} }
+func (vec Vector4d) XWX0() Vector4d { return Vector4d{vec.X, vec.W, vec.X, 0} }
+func (vec Vector4d) XWX1() Vector4d { return Vector4d{vec.X, vec.W, vec.X, 1} }
+func (vec Vector4d) XWY0() Vector4d { return Vector4d{vec.X, vec.W, vec.Y, 0} }
+func (vec Vector4d) XWY1() Vector4d { return Vector4d{vec.X, vec.W, vec.Y, 1} }
+func (vec Vector4d) XWZ0() Vector4d { return Vector4d{vec.X, vec.W, vec.Z, 0} }
+func (vec Vector4d) XWZ1() Vector4d { return Vector4d{vec.X, vec.W, vec.Z, 1} }
+func (vec Vector4d) XWW0() Vector4d { return Vector4d{vec.X, vec.W, vec.W, 0} }
+func (vec Vector4d) XWW1() Vector4d { return Vector4d{vec.X, vec.W, vec.W, 1} }
+func (vec Vector4d) YXX0() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, 0} }
+func (vec Vector4d) YXX1() Vector4d { return Vector4d{vec.Y, vec.X, vec.X, 1} }
+func (vec Vector4d) YXY0() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, 0} }
+func (vec Vector4d) YXY1() Vector4d { return Vector4d{vec.Y, vec.X, vec.Y, 1} }
+func (vec Vector4d) YXZ0() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, 0} }
+func (vec Vector4d) YXZ1() Vector4d { return Vector4d{vec.Y, vec.X, vec.Z, 1} }
+func (vec Vector4d) YXW0() Vector4d { return Vector4d{vec.Y, vec.X, vec.W, 0} }
+func (vec Vector4d) YXW1() Vector4d { return Vector4d{vec.Y, vec.X, vec.W, 1} }
+func (vec Vector4d) YYX0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, 0} }
+func (vec Vector4d) YYX1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.X, 1} }
+func (vec Vector4d) YYY0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, 0} }
+func (vec Vector4d) YYY1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Y, 1} }
+func (vec Vector4d) YYZ0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, 0} }
+func (vec Vector4d) YYZ1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.Z, 1} }
+func (vec Vector4d) YYW0() Vector4d { return Vector4d{vec.Y, vec.Y, vec.W, 0} }
+func (vec Vector4d) YYW1() Vector4d { return Vector4d{vec.Y, vec.Y, vec.W, 1} }
+func (vec Vector4d) YZX0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, 0} }
+func (vec Vector4d) YZX1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.X, 1} }
+func (vec Vector4d) YZY0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, 0} }
+func (vec Vector4d) YZY1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Y, 1} }
+func (vec Vector4d) YZZ0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, 0} }
+func (vec Vector4d) YZZ1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.Z, 1} }
+func (vec Vector4d) YZW0() Vector4d { return Vector4d{vec.Y, vec.Z, vec.W, 0} }
+func (vec Vector4d) YZW1() Vector4d { return Vector4d{vec.Y, vec.Z, vec.W, 1} }
+func (vec Vector4d) YWX0() Vector4d { return Vector4d{vec.Y, vec.W, vec.X, 0} }
+func (vec Vector4d) YWX1() Vector4d { return Vector4d{vec.Y, vec.W, vec.X, 1} }
+func (vec Vector4d) YWY0() Vector4d { return Vector4d{vec.Y, vec.W, vec.Y, 0} }
+func (vec Vector4d) YWY1() Vector4d { return Vector4d{vec.Y, vec.W, vec.Y, 1} }
+func (vec Vector4d) YWZ0() Vector4d { return Vector4d{vec.Y, vec.W, vec.Z, 0} }
+func (vec Vector4d) YWZ1() Vector4d { return Vector4d{vec.Y, vec.W, vec.Z, 1} }
+func (vec Vector4d) YWW0() Vector4d { return Vector4d{vec.Y, vec.W, vec.W, 0} }
+func (vec Vector4d) YWW1() Vector4d { return Vector4d{vec.Y, vec.W, vec.W, 1} }
+func (vec Vector4d) ZXX0() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, 0} }
+func (vec Vector4d) ZXX1() Vector4d { return Vector4d{vec.Z, vec.X, vec.X, 1} }
+func (vec Vector4d) ZXY0() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, 0} }
+func (vec Vector4d) ZXY1() Vector4d { return Vector4d{vec.Z, vec.X, vec.Y, 1} }
+func (vec Vector4d) ZXZ0() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, 0} }
+func (vec Vector4d) ZXZ1() Vector4d { return Vector4d{vec.Z, vec.X, vec.Z, 1} }
+func (vec Vector4d) ZXW0() Vector4d { return Vector4d{vec.Z, vec.X, vec.W, 0} }
+func (vec Vector4d) ZXW1() Vector4d { return Vector4d{vec.Z, vec.X, vec.W, 1} }
+func (vec Vector4d) ZYX0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, 0} }
+func (vec Vector4d) ZYX1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.X, 1} }
+func (vec Vector4d) ZYY0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, 0} }
+func (vec Vector4d) ZYY1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Y, 1} }
+func (vec Vector4d) ZYZ0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, 0} }
+func (vec Vector4d) ZYZ1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.Z, 1} }
+func (vec Vector4d) ZYW0() Vector4d { return Vector4d{vec.Z, vec.Y, vec.W, 0} }
+func (vec Vector4d) ZYW1() Vector4d { return Vector4d{vec.Z, vec.Y, vec.W, 1} }
+func (vec Vector4d) ZZX0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, 0} }
+func (vec Vector4d) ZZX1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.X, 1} }
+func (vec Vector4d) ZZY0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, 0} }
+func (vec Vector4d) ZZY1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Y, 1} }
+func (vec Vector4d) ZZZ0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, 0} }
+func (vec Vector4d) ZZZ1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.Z, 1} }
+func (vec Vector4d) ZZW0() Vector4d { return Vector4d{vec.Z, vec.Z, vec.W, 0} }
+func (vec Vector4d) ZZW1() Vector4d { return Vector4d{vec.Z, vec.Z, vec.W, 1} }
+func (vec Vector4d) ZWX0() Vector4d { return Vector4d{vec.Z, vec.W, vec.X, 0} }
+func (vec Vector4d) ZWX1() Vector4d { return Vector4d{vec.Z, vec.W, vec.X, 1} }
+func (vec Vector4d) ZWY0() Vector4d { return Vector4d{vec.Z, vec.W, vec.Y, 0} }
+func (vec Vector4d) ZWY1() Vector4d { return Vector4d{vec.Z, vec.W, vec.Y, 1} }
+func (vec Vector4d) ZWZ0() Vector4d { return Vector4d{vec.Z, vec.W, vec.Z, 0} }
+func (vec Vector4d) ZWZ1() Vector4d { return Vector4d{vec.Z, vec.W, vec.Z, 1} }
+func (vec Vector4d) ZWW0() Vector4d { return Vector4d{vec.Z, vec.W, vec.W, 0} }
+func (vec Vector4d) ZWW1() Vector4d { return Vector4d{vec.Z, vec.W, vec.W, 1} }
+func (vec Vector4d) WXX0() Vector4d { return Vector4d{vec.W, vec.X, vec.X, 0} }
+func (vec Vector4d) WXX1() Vector4d { return Vector4d{vec.W, vec.X, vec.X, 1} }
+func (vec Vector4d) WXY0() Vector4d { return Vector4d{vec.W, vec.X, vec.Y, 0} }
+func (vec Vector4d) WXY1() Vector4d { return Vector4d{vec.W, vec.X, vec.Y, 1} }
+func (vec Vector4d) WXZ0() Vector4d { return Vector4d{vec.W, vec.X, vec.Z, 0} }
+func (vec Vector4d) WXZ1() Vector4d { return Vector4d{vec.W, vec.X, vec.Z, 1} }
+func (vec Vector4d) WXW0() Vector4d { return Vector4d{vec.W, vec.X, vec.W, 0} }
+func (vec Vector4d) WXW1() Vector4d { return Vector4d{vec.W, vec.X, vec.W, 1} }
+func (vec Vector4d) WYX0() Vector4d { return Vector4d{vec.W, vec.Y, vec.X, 0} }
+func (vec Vector4d) WYX1() Vector4d { return Vector4d{vec.W, vec.Y, vec.X, 1} }
+func (vec Vector4d) WYY0() Vector4d { return Vector4d{vec.W, vec.Y, vec.Y, 0} }
+func (vec Vector4d) WYY1() Vector4d { return Vector4d{vec.W, vec.Y, vec.Y, 1} }
+func (vec Vector4d) WYZ0() Vector4d { return Vector4d{vec.W, vec.Y, vec.Z, 0} }
+func (vec Vector4d) WYZ1() Vector4d { return Vector4d{vec.W, vec.Y, vec.Z, 1} }
+func (vec Vector4d) WYW0() Vector4d { return Vector4d{vec.W, vec.Y, vec.W, 0} }
+func (vec Vector4d) WYW1() Vector4d { return Vector4d{vec.W, vec.Y, vec.W, 1} }
+func (vec Vector4d) WZX0() Vector4d { return Vector4d{vec.W, vec.Z, vec.X, 0} }
+func (vec Vector4d) WZX1() Vector4d { return Vector4d{vec.W, vec.Z, vec.X, 1} }
+func (vec Vector4d) WZY0() Vector4d { return Vector4d{vec.W, vec.Z, vec.Y, 0} }
+func (vec Vector4d) WZY1() Vector4d { return Vector4d{vec.W, vec.Z, vec.Y, 1} }
+func (vec Vector4d) WZZ0() Vector4d { return Vector4d{vec.W, vec.Z, vec.Z, 0} }
+func (vec Vector4d) WZZ1() Vector4d { return Vector4d{vec.W, vec.Z, vec.Z, 1} }
+func (vec Vector4d) WZW0() Vector4d { return Vector4d{vec.W, vec.Z, vec.W, 0} }
+func (vec Vector4d) WZW1() Vector4d { return Vector4d{vec.W, vec.Z, vec.W, 1} }
+func (vec Vector4d) WWX0() Vector4d { return Vector4d{vec.W, vec.W, vec.X, 0} }
+func (vec Vector4d) WWX1() Vector4d { return Vector4d{vec.W, vec.W, vec.X, 1} }
+func (vec Vector4d) WWY0() Vector4d { return Vector4d{vec.W, vec.W, vec.Y, 0} }
+func (vec Vector4d) WWY1() Vector4d { return Vector4d{vec.W, vec.W, vec.Y, 1} }
+func (vec Vector4d) WWZ0() Vector4d { return Vector4d{vec.W, vec.W, vec.Z, 0} }
+func (vec Vector4d) WWZ1() Vector4d { return Vector4d{vec.W, vec.W, vec.Z, 1} }
+func (vec Vector4d) WWW0() Vector4d { return Vector4d{vec.W, vec.W, vec.W, 0} }
+func (vec Vector4d) WWW1() Vector4d { return Vector4d{vec.W, vec.W, vec.W, 1} }
@@ -0,0 +1,86 @@
+//go:build ignore
+// +build ignore
+
+// Generates the swizzle methods of Vector2, Vector3 and Vector4 and their float64 counterparts.
+// Every combination of 2 to 4 components is generated, e.g. Vector3.ZYX or Vector4.XXYY.
+// Combinations of 1 to 3 components can be followed by the constant 0 or 1, e.g. Vector3.XYZ1.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+)
+
+var vectors = []struct {
+	name       string
+	components string
+}{
+	{"Vector2", "XY"},
+	{"Vector3", "XYZ"},
+	{"Vector4", "XYZW"},
+}
+
+func main() {
+	generate("swizzle.go", "")
+	generate("swizzled.go", "d")
+}
+
+func generate(filename, suffix string) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by swizzlegen.go; DO NOT EDIT.\n\npackage math\n")
+	for _, vec := range vectors {
+		fmt.Fprintf(&buf, "\n// Swizzles of %s%s\n\n", vec.name, suffix)
+		for _, swizzle := range swizzles(vec.components) {
+			fields := make([]string, len(swizzle))
+			for i, c := range swizzle {
+				if c == '0' || c == '1' {
+					fields[i] = string(c)
+				} else {
+					fields[i] = "vec." + string(c)
+				}
+			}
+			fmt.Fprintf(&buf, "func (vec %s%s) %s() Vector%d%s { return Vector%d%s{%s} }\n",
+				vec.name, suffix, swizzle, len(swizzle), suffix, len(swizzle), suffix, strings.Join(fields, ", "))
+		}
+	}
+	out, err := format.Source(buf.Bytes())
+	check(err)
+	check(os.WriteFile(filename, out, 0644))
+}
+
+func swizzles(components string) []string {
+	var result []string
+	var combine func(prefix string, length int)
+	combine = func(prefix string, length int) {
+		if len(prefix) == length {
+			result = append(result, prefix)
+			return
+		}
+		for _, c := range components {
+			combine(prefix+string(c), length)
+		}
+	}
+	for length := 2; length <= 4; length++ {
+		combine("", length)
+	}
+	var constant []string
+	for length := 1; length <= 3; length++ {
+		start := len(result)
+		combine("", length)
+		for _, s := range result[start:] {
+			constant = append(constant, s+"0", s+"1")
+		}
+		result = result[:start]
+	}
+	return append(result, constant...)
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,130 @@
+package math
+
+// A triangle in 3D-space with the corners A, B and C. The front side is the one from which the corners
+// appear in counter-clockwise order.
+type Triangle struct {
+	A, B, C Vector3
+}
+
+func NewTriangle(a, b, c Vector3) *Triangle {
+	return &Triangle{a, b, c}
+}
+
+// Returns the unit normal of the front side.
+func (t *Triangle) Normal() Vector3 {
+	return t.B.Sub(t.A).Cross(t.C.Sub(t.A)).Nor()
+}
+
+func (t *Triangle) Area() float32 {
+	return t.B.Sub(t.A).Cross(t.C.Sub(t.A)).Len() / 2
+}
+
+// Returns the smallest axis aligned box around the triangle.
+func (t *Triangle) Bounds() BoundingBox {
+	return BoundingBox{Min: t.A.Min(t.B).Min(t.C), Max: t.A.Max(t.B).Max(t.C)}
+}
+
+// Returns the barycentric coordinates of the point projected onto the plane of the triangle,
+// so that point = u*A + v*B + w*C. The point is inside of the triangle if all are in the range [0,1].
+func (t *Triangle) Barycentric(point Vector3) (u, v, w float32) {
+	v0, v1, v2 := t.B.Sub(t.A), t.C.Sub(t.A), point.Sub(t.A)
+	d00, d01, d11 := v0.Dot(v0), v0.Dot(v1), v1.Dot(v1)
+	d20, d21 := v2.Dot(v0), v2.Dot(v1)
+	denom := d00*d11 - d01*d01
+	v = (d11*d20 - d01*d21) / denom
+	w = (d00*d21 - d01*d20) / denom
+	return 1 - v - w, v, w
+}
+
+// Returns whether the point is inside the triangle.
+// This assumes that the point is on the plane of the triangle.
+// No check is performed that this is the case.
+func (t *Triangle) ContainsVec(point Vector3) bool {
+	v0 := t.A.Sub(point)
+	v1 := t.B.Sub(point)
+	v2 := t.C.Sub(point)
+
+	ab := v0.Dot(v1)
+	ac := v0.Dot(v2)
+	bc := v1.Dot(v2)
+	cc := v2.Dot(v2)
+
+	if bc*ac-cc*ab < 0 {
+		return false
+	}
+	bb := v1.Dot(v1)
+	if ab*bc-ac*bb < 0 {
+		return false
+	}
+	return true
+}
+
+// Returns the point of the triangle nearest to the point.
+func (t *Triangle) ClosestPoint(point Vector3) Vector3 {
+	// Find the Voronoi region of the point, see Ericson, Real-Time Collision Detection, 5.1.5.
+	ab, ac, ap := t.B.Sub(t.A), t.C.Sub(t.A), point.Sub(t.A)
+	d1, d2 := ab.Dot(ap), ac.Dot(ap)
+	if d1 <= 0 && d2 <= 0 {
+		return t.A
+	}
+	bp := point.Sub(t.B)
+	d3, d4 := ab.Dot(bp), ac.Dot(bp)
+	if d3 >= 0 && d4 <= d3 {
+		return t.B
+	}
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return t.A.Add(ab.Scale(d1 / (d1 - d3)))
+	}
+	cp := point.Sub(t.C)
+	d5, d6 := ab.Dot(cp), ac.Dot(cp)
+	if d6 >= 0 && d5 <= d6 {
+		return t.C
+	}
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return t.A.Add(ac.Scale(d2 / (d2 - d6)))
+	}
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		return t.B.Add(t.C.Sub(t.B).Scale((d4 - d3) / ((d4 - d3) + (d5 - d6))))
+	}
+	denom := 1 / (va + vb + vc)
+	return t.A.Add(ab.Scale(vb * denom)).Add(ac.Scale(vc * denom))
+}
+
+// Returns the corner of the triangle farthest in the direction.
+func (t *Triangle) Support(direction Vector3) Vector3 {
+	support := t.A
+	if t.B.Dot(direction) > support.Dot(direction) {
+		support = t.B
+	}
+	if t.C.Dot(direction) > support.Dot(direction) {
+		support = t.C
+	}
+	return support
+}
+
+// Returns whether the ray hits either side of the triangle and the distance of the hit in multiples of the
+// ray direction, using the algorithm of Möller and Trumbore.
+func (t *Triangle) IntersectRay(ray *Ray) (float32, bool) {
+	e1, e2 := t.B.Sub(t.A), t.C.Sub(t.A)
+	p := ray.Direction.Cross(e2)
+	det := e1.Dot(p)
+	if det == 0 {
+		return 0, false
+	}
+	invDet := 1 / det
+	s := ray.Origin.Sub(t.A)
+	u := s.Dot(p) * invDet
+	if u < 0 || u > 1 {
+		return 0, false
+	}
+	q := s.Cross(e1)
+	v := ray.Direction.Dot(q) * invDet
+	if v < 0 || u+v > 1 {
+		return 0, false
+	}
+	distance := e2.Dot(q) * invDet
+	return distance, distance >= 0
+}
@@ -0,0 +1,58 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type TriangleTestSuite struct{}
+
+var _ = Suite(&TriangleTestSuite{})
+
+func (s *TriangleTestSuite) TestProperties(c *C) {
+	t := NewTriangle(Vec3(0, 0, 0), Vec3(2, 0, 0), Vec3(0, 2, 0))
+	c.Check(t.Normal(), Equals, Vec3(0, 0, 1))
+	c.Check(NewTriangle(t.A, t.C, t.B).Normal(), Equals, Vec3(0, 0, -1))
+	c.Check(t.Area(), Equals, float32(2))
+	c.Check(t.Bounds(), Equals, BoundingBox{Min: Vec3(0, 0, 0), Max: Vec3(2, 2, 0)})
+	c.Check(t.Support(Vec3(1, 0.1, 0)), Equals, t.B)
+	c.Check(t.Support(Vec3(-1, -1, 5)), Equals, t.A)
+
+	u, v, w := t.Barycentric(Vec3(0.5, 1, 0))
+	c.Check(u, EqualsFloat32, float32(0.25))
+	c.Check(v, EqualsFloat32, float32(0.25))
+	c.Check(w, EqualsFloat32, float32(0.5))
+	u, v, w = t.Barycentric(Vec3(3, 0, 7))
+	c.Check([]float32{u, v, w}, DeepEquals, []float32{-0.5, 1.5, 0})
+
+	c.Check(t.ContainsVec(Vec3(0.5, 0.5, 0)), Equals, true)
+	c.Check(t.ContainsVec(Vec3(1.5, 1, 0)), Equals, false)
+	c.Check(t.ContainsVec(t.B), Equals, true)
+}
+
+func (s *TriangleTestSuite) TestClosestPoint(c *C) {
+	t := NewTriangle(Vec3(0, 0, 0), Vec3(2, 0, 0), Vec3(0, 2, 0))
+	c.Check(t.ClosestPoint(Vec3(0.5, 0.5, 3)), Equals, Vec3(0.5, 0.5, 0))
+	c.Check(t.ClosestPoint(Vec3(-1, -1, 1)), Equals, t.A)
+	c.Check(t.ClosestPoint(Vec3(3, -1, 0)), Equals, t.B)
+	c.Check(t.ClosestPoint(Vec3(-1, 3, 0)), Equals, t.C)
+	c.Check(t.ClosestPoint(Vec3(1, -1, 0)), Equals, Vec3(1, 0, 0))
+	c.Check(t.ClosestPoint(Vec3(-1, 1, 0)), Equals, Vec3(0, 1, 0))
+	c.Check(t.ClosestPoint(Vec3(2, 2, -1)), Equals, Vec3(1, 1, 0))
+}
+
+func (s *TriangleTestSuite) TestIntersectRay(c *C) {
+	t := NewTriangle(Vec3(0, 0, 0), Vec3(2, 0, 0), Vec3(0, 2, 0))
+	distance, ok := t.IntersectRay(NewRay(Vec3(0.5, 0.5, 2), Vec3(0, 0, -0.5)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, Equals, float32(4))
+	// The back side is hit as well.
+	distance, ok = t.IntersectRay(NewRay(Vec3(0.5, 0.5, -2), Vec3(0, 0, 1)))
+	c.Check(ok, Equals, true)
+	c.Check(distance, Equals, float32(2))
+	_, ok = t.IntersectRay(NewRay(Vec3(0.5, 0.5, 2), Vec3(0, 0, 1)))
+	c.Check(ok, Equals, false)
+	_, ok = t.IntersectRay(NewRay(Vec3(1.5, 1, 2), Vec3(0, 0, -1)))
+	c.Check(ok, Equals, false)
+	_, ok = t.IntersectRay(NewRay(Vec3(0.5, 0.5, 2), Vec3(1, 0, 0)))
+	c.Check(ok, Equals, false)
+}
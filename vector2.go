@@ -146,7 +146,7 @@ func (vec *Vector2) SetAngle(angle float32) Vector2 {
 	return vec.SetVec2(v)
 }
 
-// Returns the rotated Vector2 by the given angle, counter-clockwise.
+// Returns the rotated Vector2 by the given angle in degrees, counter-clockwise.
 func (vec Vector2) Rotate(degrees float32) Vector2 {
 	rad := degrees * DegreeToRadians
 	cos := Cos(rad)
@@ -159,6 +159,11 @@ func (vec Vector2) Rotate(degrees float32) Vector2 {
 	return vec
 }
 
+// Returns the rotated Vector2 by the given angle, counter-clockwise.
+func (vec Vector2) RotateAngle(angle Angle) Vector2 {
+	return vec.Rotate(float32(angle.Degrees()))
+}
+
 // Lerp returns the linearly interpolates between this vector and the target vector by alpha which is in the range [0,1].
 func (vec Vector2) Lerp(target Vector2, alpha float32) Vector2 {
 	invAlpha := 1.0 - alpha
@@ -174,3 +179,86 @@ func (vec Vector2) Faceforward(i, n Vector2) Vector2 {
 	}
 	return Vector2{-vec.X, -vec.Y}
 }
+
+// Returns the point of the cell containing this vector, each coordinate is rounded down.
+func (vec Vector2) FloorPoint() Point {
+	return Point{int(Floor(vec.X)), int(Floor(vec.Y))}
+}
+
+// Returns the nearest point, halves are rounded up.
+func (vec Vector2) RoundPoint() Point {
+	return Point{int(Floor(vec.X + 0.5)), int(Floor(vec.Y + 0.5))}
+}
+
+// Returns the point with each coordinate rounded up.
+func (vec Vector2) CeilPoint() Point {
+	return Point{int(Ceil(vec.X)), int(Ceil(vec.Y))}
+}
+
+// Returns the componentwise minimum of both vectors.
+func (vec Vector2) Min(vec2 Vector2) Vector2 {
+	return Vec2(Min(vec.X, vec2.X), Min(vec.Y, vec2.Y))
+}
+
+// Returns the componentwise maximum of both vectors.
+func (vec Vector2) Max(vec2 Vector2) Vector2 {
+	return Vec2(Max(vec.X, vec2.X), Max(vec.Y, vec2.Y))
+}
+
+// Returns the vector with the absolute values of its components.
+func (vec Vector2) Abs() Vector2 {
+	return Vec2(Abs(vec.X), Abs(vec.Y))
+}
+
+// Returns the vector with each component rounded down.
+func (vec Vector2) Floor() Vector2 {
+	return Vec2(Floor(vec.X), Floor(vec.Y))
+}
+
+// Returns the vector with each component rounded up.
+func (vec Vector2) Ceil() Vector2 {
+	return Vec2(Ceil(vec.X), Ceil(vec.Y))
+}
+
+// Returns the vector with each component rounded to the nearest integer, halves are rounded away from zero.
+func (vec Vector2) Round() Vector2 {
+	return Vec2(Round(vec.X), Round(vec.Y))
+}
+
+// Returns the smallest component.
+func (vec Vector2) MinComponent() float32 {
+	return Min(vec.X, vec.Y)
+}
+
+// Returns the largest component.
+func (vec Vector2) MaxComponent() float32 {
+	return Max(vec.X, vec.Y)
+}
+
+// Returns the component with the index i, X has the index 0.
+func (vec Vector2) Get(i int) float32 {
+	switch i {
+	case 0:
+		return vec.X
+	case 1:
+		return vec.Y
+	}
+	panic(ErrIndexOutOfRange)
+}
+
+// Sets the component with the index i, X has the index 0.
+func (vec *Vector2) SetIdx(i int, value float32) Vector2 {
+	switch i {
+	case 0:
+		vec.X = value
+	case 1:
+		vec.Y = value
+	default:
+		panic(ErrIndexOutOfRange)
+	}
+	return *vec
+}
+
+func (vec Vector2) ToArray() [2]float32 {
+	return [2]float32{vec.X, vec.Y}
+}
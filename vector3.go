@@ -133,9 +133,7 @@ func (vec Vector3) IsZero() bool {
 
 // Linearly interpolates between this vector and the target vector by alpha which is in the range [0,1].
 func (vec Vector3) Lerp(target Vector3, alpha float32) Vector3 {
-	vec.Scale(1.0 - alpha)
-	vec.Add(target.Cpy().Scale(alpha))
-	return vec
+	return vec.Scale(1.0 - alpha).Add(target.Scale(alpha))
 }
 
 // Spherically interpolates between this vector and the target vector by alpha which is in the range [0,1].
@@ -183,3 +181,90 @@ func (vec Vector3) Invert() Vector3 {
 	vec.Z = -vec.Z
 	return vec
 }
+
+// Returns the point of the cell containing this vector, each coordinate is rounded down.
+func (vec Vector3) FloorPoint3() Point3 {
+	return Point3{int(Floor(vec.X)), int(Floor(vec.Y)), int(Floor(vec.Z))}
+}
+
+// Returns the nearest point, halves are rounded up.
+func (vec Vector3) RoundPoint3() Point3 {
+	return Point3{int(Floor(vec.X + 0.5)), int(Floor(vec.Y + 0.5)), int(Floor(vec.Z + 0.5))}
+}
+
+// Returns the point with each coordinate rounded up.
+func (vec Vector3) CeilPoint3() Point3 {
+	return Point3{int(Ceil(vec.X)), int(Ceil(vec.Y)), int(Ceil(vec.Z))}
+}
+
+// Returns the componentwise minimum of both vectors.
+func (vec Vector3) Min(vec2 Vector3) Vector3 {
+	return Vec3(Min(vec.X, vec2.X), Min(vec.Y, vec2.Y), Min(vec.Z, vec2.Z))
+}
+
+// Returns the componentwise maximum of both vectors.
+func (vec Vector3) Max(vec2 Vector3) Vector3 {
+	return Vec3(Max(vec.X, vec2.X), Max(vec.Y, vec2.Y), Max(vec.Z, vec2.Z))
+}
+
+// Returns the vector with the absolute values of its components.
+func (vec Vector3) Abs() Vector3 {
+	return Vec3(Abs(vec.X), Abs(vec.Y), Abs(vec.Z))
+}
+
+// Returns the vector with each component rounded down.
+func (vec Vector3) Floor() Vector3 {
+	return Vec3(Floor(vec.X), Floor(vec.Y), Floor(vec.Z))
+}
+
+// Returns the vector with each component rounded up.
+func (vec Vector3) Ceil() Vector3 {
+	return Vec3(Ceil(vec.X), Ceil(vec.Y), Ceil(vec.Z))
+}
+
+// Returns the vector with each component rounded to the nearest integer, halves are rounded away from zero.
+func (vec Vector3) Round() Vector3 {
+	return Vec3(Round(vec.X), Round(vec.Y), Round(vec.Z))
+}
+
+// Returns the smallest component.
+func (vec Vector3) MinComponent() float32 {
+	return Min(Min(vec.X, vec.Y), vec.Z)
+}
+
+// Returns the largest component.
+func (vec Vector3) MaxComponent() float32 {
+	return Max(Max(vec.X, vec.Y), vec.Z)
+}
+
+// Returns the component with the index i, X has the index 0.
+func (vec Vector3) Get(i int) float32 {
+	switch i {
+	case 0:
+		return vec.X
+	case 1:
+		return vec.Y
+	case 2:
+		return vec.Z
+	}
+	panic(ErrIndexOutOfRange)
+}
+
+// Sets the component with the index i, X has the index 0.
+func (vec *Vector3) SetIdx(i int, value float32) Vector3 {
+	switch i {
+	case 0:
+		vec.X = value
+	case 1:
+		vec.Y = value
+	case 2:
+		vec.Z = value
+	default:
+		panic(ErrIndexOutOfRange)
+	}
+	return *vec
+}
+
+func (vec Vector3) ToArray() [3]float32 {
+	return [3]float32{vec.X, vec.Y, vec.Z}
+}
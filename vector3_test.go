@@ -97,7 +97,6 @@ func (s *Vector3TestSuite) Vector3Cross(c *C) {
 // TODO Rot
 // TODO IsUnit
 // TODO IsZero
-// TODO Lerp
 // TODO Slerp
 
 func (s *Vector3TestSuite) Vector3Limit(c *C) {
@@ -114,3 +113,43 @@ func (s *Vector3TestSuite) Vector3Invert(c *C) {
 	s.vec.SetVec3(Vec3(4, 0, -2))
 	c.Assert(s.vec.Invert(), Equals, Vec3(-4, 0, -2))
 }
+
+func (s *Vector3TestSuite) TestSwizzle(c *C) {
+	v := Vec3(1, 2, 3)
+	c.Check(v.XY(), Equals, Vec2(1, 2))
+	c.Check(v.ZYX(), Equals, Vec3(3, 2, 1))
+	c.Check(v.XYZ1(), Equals, Vec4(1, 2, 3, 1))
+	c.Check(v.XZ0(), Equals, Vec3(1, 3, 0))
+	c.Check(v.ZZXY(), Equals, Vec4(3, 3, 1, 2))
+}
+
+func (s *Vector3TestSuite) TestComponentwise(c *C) {
+	v := Vec3(-1.5, 2.5, 0.25)
+	c.Check(v.Min(Vec3(0, 0, 0)), Equals, Vec3(-1.5, 0, 0))
+	c.Check(v.Max(Vec3(0, 0, 0)), Equals, Vec3(0, 2.5, 0.25))
+	c.Check(v.Abs(), Equals, Vec3(1.5, 2.5, 0.25))
+	c.Check(v.Floor(), Equals, Vec3(-2, 2, 0))
+	c.Check(v.Ceil(), Equals, Vec3(-1, 3, 1))
+	c.Check(v.Round(), Equals, Vec3(-2, 3, 0))
+	c.Check(v.MinComponent(), Equals, float32(-1.5))
+	c.Check(v.MaxComponent(), Equals, float32(2.5))
+	c.Check(v.ToArray(), Equals, [3]float32{-1.5, 2.5, 0.25})
+}
+
+func (s *Vector3TestSuite) TestIndex(c *C) {
+	v := Vec3(1, 2, 3)
+	c.Check(v.Get(2), Equals, float32(3))
+	c.Check(v.SetIdx(1, 5), Equals, Vec3(1, 5, 3))
+	c.Check(v, Equals, Vec3(1, 5, 3))
+	c.Check(func() { v.Get(3) }, PanicMatches, "index out of range")
+}
+
+// Lerp used to discard the results of Scale and Add and returned the vector unchanged.
+func (s *Vector3TestSuite) TestLerp(c *C) {
+	v := Vec3(1, 2, 3)
+	c.Check(v.Lerp(Vec3(3, 2, 1), 0.25), Vector3Check, Vec3(1.5, 2, 2.5))
+	c.Check(v.Lerp(Vec3(3, 2, 1), 0), Equals, v)
+	c.Check(v.Lerp(Vec3(3, 2, 1), 1), Equals, Vec3(3, 2, 1))
+	c.Check(Vec3(0, 0, 0).Lerp(Vec3(2, 4, 6), 0.5), Equals, Vec3(1, 2, 3))
+	c.Check(v, Equals, Vec3(1, 2, 3))
+}
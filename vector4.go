@@ -85,6 +85,37 @@ func (vec Vector4) Div(vec2 Vector4) Vector4 {
 	return vec
 }
 
+func (vec Vector4) Dot(vec2 Vector4) float32 {
+	return vec.X*vec2.X + vec.Y*vec2.Y + vec.Z*vec2.Z + vec.W*vec2.W
+}
+
+// The euclidian length
+func (vec Vector4) Len() float32 {
+	return Sqrt(vec.Len2())
+}
+
+// The squared euclidian length
+func (vec Vector4) Len2() float32 {
+	return vec.Dot(vec)
+}
+
+func (vec Vector4) Distance(vec2 Vector4) float32 {
+	return vec.Sub(vec2).Len()
+}
+
+func (vec Vector4) Distance2(vec2 Vector4) float32 {
+	return vec.Sub(vec2).Len2()
+}
+
+// Normalizes this vector
+func (vec Vector4) Nor() Vector4 {
+	l := vec.Len()
+	if l == 0 {
+		return vec
+	}
+	return vec.Scale(1 / l)
+}
+
 func (vec Vector4) IsZero() bool {
 	return vec.X == 0 && vec.Y == 0 && vec.Z == 0 && vec.W == 0
 }
@@ -104,3 +135,84 @@ func (vec Vector4) Invert() Vector4 {
 	vec.W = -vec.W
 	return vec
 }
+
+// Lerp returns the linearly interpolates between this vector and the target vector by alpha which is in the range [0,1].
+func (vec Vector4) Lerp(target Vector4, alpha float32) Vector4 {
+	return vec.Scale(1.0 - alpha).Add(target.Scale(alpha))
+}
+
+// Returns the componentwise minimum of both vectors.
+func (vec Vector4) Min(vec2 Vector4) Vector4 {
+	return Vec4(Min(vec.X, vec2.X), Min(vec.Y, vec2.Y), Min(vec.Z, vec2.Z), Min(vec.W, vec2.W))
+}
+
+// Returns the componentwise maximum of both vectors.
+func (vec Vector4) Max(vec2 Vector4) Vector4 {
+	return Vec4(Max(vec.X, vec2.X), Max(vec.Y, vec2.Y), Max(vec.Z, vec2.Z), Max(vec.W, vec2.W))
+}
+
+// Returns the vector with the absolute values of its components.
+func (vec Vector4) Abs() Vector4 {
+	return Vec4(Abs(vec.X), Abs(vec.Y), Abs(vec.Z), Abs(vec.W))
+}
+
+// Returns the vector with each component rounded down.
+func (vec Vector4) Floor() Vector4 {
+	return Vec4(Floor(vec.X), Floor(vec.Y), Floor(vec.Z), Floor(vec.W))
+}
+
+// Returns the vector with each component rounded up.
+func (vec Vector4) Ceil() Vector4 {
+	return Vec4(Ceil(vec.X), Ceil(vec.Y), Ceil(vec.Z), Ceil(vec.W))
+}
+
+// Returns the vector with each component rounded to the nearest integer, halves are rounded away from zero.
+func (vec Vector4) Round() Vector4 {
+	return Vec4(Round(vec.X), Round(vec.Y), Round(vec.Z), Round(vec.W))
+}
+
+// Returns the smallest component.
+func (vec Vector4) MinComponent() float32 {
+	return Min(Min(Min(vec.X, vec.Y), vec.Z), vec.W)
+}
+
+// Returns the largest component.
+func (vec Vector4) MaxComponent() float32 {
+	return Max(Max(Max(vec.X, vec.Y), vec.Z), vec.W)
+}
+
+// Returns the component with the index i, X has the index 0.
+func (vec Vector4) Get(i int) float32 {
+	switch i {
+	case 0:
+		return vec.X
+	case 1:
+		return vec.Y
+	case 2:
+		return vec.Z
+	case 3:
+		return vec.W
+	}
+	panic(ErrIndexOutOfRange)
+}
+
+// Sets the component with the index i, X has the index 0.
+func (vec *Vector4) SetIdx(i int, value float32) Vector4 {
+	switch i {
+	case 0:
+		vec.X = value
+	case 1:
+		vec.Y = value
+	case 2:
+		vec.Z = value
+	case 3:
+		vec.W = value
+	default:
+		panic(ErrIndexOutOfRange)
+	}
+	return *vec
+}
+
+func (vec Vector4) ToArray() [4]float32 {
+	return [4]float32{vec.X, vec.Y, vec.Z, vec.W}
+}
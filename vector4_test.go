@@ -0,0 +1,37 @@
+package math
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type Vector4TestSuite struct{}
+
+var _ = Suite(&Vector4TestSuite{})
+
+func (s *Vector4TestSuite) TestDotLen(c *C) {
+	v := Vec4(1, 2, 2, 4)
+	c.Check(v.Dot(Vec4(1, 0, -1, 0.5)), Equals, float32(1))
+	c.Check(v.Len2(), Equals, float32(25))
+	c.Check(v.Len(), Equals, float32(5))
+	c.Check(v.Nor(), Vector4Check, Vec4(0.2, 0.4, 0.4, 0.8))
+	c.Check(Vec4(0, 0, 0, 0).Nor(), Equals, Vec4(0, 0, 0, 0))
+	c.Check(v.Distance(Vec4(1, 2, 2, 0)), Equals, float32(4))
+}
+
+func (s *Vector4TestSuite) TestLerp(c *C) {
+	c.Check(Vec4(0, 2, 4, 8).Lerp(Vec4(4, 2, 0, 0), 0.25), Vector4Check, Vec4(1, 2, 3, 6))
+}
+
+func (s *Vector4TestSuite) TestComponentwise(c *C) {
+	v := Vec4(-1.5, 2.5, 0.25, 7)
+	c.Check(v.Min(Vec4(0, 0, 0, 0)), Equals, Vec4(-1.5, 0, 0, 0))
+	c.Check(v.Max(Vec4(0, 3, 0, 0)), Equals, Vec4(0, 3, 0.25, 7))
+	c.Check(v.Round(), Equals, Vec4(-2, 3, 0, 7))
+	c.Check(v.MinComponent(), Equals, float32(-1.5))
+	c.Check(v.MaxComponent(), Equals, float32(7))
+	c.Check(v.Get(3), Equals, float32(7))
+	c.Check(v.SetIdx(0, 1), Equals, Vec4(1, 2.5, 0.25, 7))
+	c.Check(v.ToArray(), Equals, [4]float32{1, 2.5, 0.25, 7})
+	c.Check(v.WZYX(), Equals, Vec4(7, 0.25, 2.5, 1))
+	c.Check(v.XY(), Equals, Vec2(1, 2.5))
+}
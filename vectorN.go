@@ -0,0 +1,173 @@
+package math
+
+// A vector with an arbitrary number of dimensions.
+// All operations returning a vector allocate a new one. Like MatrixN, operations on two vectors return
+// ErrDimensionMismatch if their dimensions don't match.
+type VectorN []float32
+
+func VecN(values ...float32) VectorN {
+	vec := make(VectorN, len(values))
+	copy(vec, values)
+	return vec
+}
+
+// Returns a zero vector with n dimensions.
+func NewVectorN(n int) VectorN {
+	return make(VectorN, n)
+}
+
+func (vec VectorN) Cpy() VectorN {
+	return VecN(vec...)
+}
+
+// The number of dimensions
+func (vec VectorN) Dim() int {
+	return len(vec)
+}
+
+func (vec VectorN) Add(vec2 VectorN) (VectorN, error) {
+	if len(vec) != len(vec2) {
+		return nil, ErrDimensionMismatch
+	}
+	out := make(VectorN, len(vec))
+	for i := range vec {
+		out[i] = vec[i] + vec2[i]
+	}
+	return out, nil
+}
+
+func (vec VectorN) Sub(vec2 VectorN) (VectorN, error) {
+	if len(vec) != len(vec2) {
+		return nil, ErrDimensionMismatch
+	}
+	out := make(VectorN, len(vec))
+	for i := range vec {
+		out[i] = vec[i] - vec2[i]
+	}
+	return out, nil
+}
+
+func (vec VectorN) Mul(vec2 VectorN) (VectorN, error) {
+	if len(vec) != len(vec2) {
+		return nil, ErrDimensionMismatch
+	}
+	out := make(VectorN, len(vec))
+	for i := range vec {
+		out[i] = vec[i] * vec2[i]
+	}
+	return out, nil
+}
+
+func (vec VectorN) Scale(scalar float32) VectorN {
+	out := make(VectorN, len(vec))
+	for i := range vec {
+		out[i] = vec[i] * scalar
+	}
+	return out
+}
+
+func (vec VectorN) Dot(vec2 VectorN) (float32, error) {
+	if len(vec) != len(vec2) {
+		return 0, ErrDimensionMismatch
+	}
+	var dot float32
+	for i := range vec {
+		dot += vec[i] * vec2[i]
+	}
+	return dot, nil
+}
+
+// The euclidian length
+func (vec VectorN) Len() float32 {
+	return Sqrt(vec.Len2())
+}
+
+// The squared euclidian length
+func (vec VectorN) Len2() float32 {
+	var len2 float32
+	for _, v := range vec {
+		len2 += v * v
+	}
+	return len2
+}
+
+// Returns the normalized vector
+func (vec VectorN) Nor() VectorN {
+	l := vec.Len()
+	if l == 0 {
+		return vec.Cpy()
+	}
+	return vec.Scale(1 / l)
+}
+
+func (vec VectorN) Distance(vec2 VectorN) (float32, error) {
+	d, err := vec.Sub(vec2)
+	if err != nil {
+		return 0, err
+	}
+	return d.Len(), nil
+}
+
+func (vec VectorN) Equals(vec2 VectorN) bool {
+	if len(vec) != len(vec2) {
+		return false
+	}
+	for i := range vec {
+		if vec[i] != vec2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the vector as a Vector2, it has to have two dimensions.
+func (vec VectorN) Vec2() (Vector2, error) {
+	if len(vec) != 2 {
+		return Vector2{}, ErrDimensionMismatch
+	}
+	return Vec2(vec[0], vec[1]), nil
+}
+
+// Returns the vector as a Vector3, it has to have three dimensions.
+func (vec VectorN) Vec3() (Vector3, error) {
+	if len(vec) != 3 {
+		return Vector3{}, ErrDimensionMismatch
+	}
+	return Vec3(vec[0], vec[1], vec[2]), nil
+}
+
+// Returns the vector as a Vector4, it has to have four dimensions.
+func (vec VectorN) Vec4() (Vector4, error) {
+	if len(vec) != 4 {
+		return Vector4{}, ErrDimensionMismatch
+	}
+	return Vec4(vec[0], vec[1], vec[2], vec[3]), nil
+}
+
+func (vec Vector2) VecN() VectorN {
+	return VecN(vec.X, vec.Y)
+}
+
+func (vec Vector3) VecN() VectorN {
+	return VecN(vec.X, vec.Y, vec.Z)
+}
+
+func (vec Vector4) VecN() VectorN {
+	return VecN(vec.X, vec.Y, vec.Z, vec.W)
+}
+
+func (vec VectorN) float64s() []float64 {
+	out := make([]float64, len(vec))
+	for i := range vec {
+		out[i] = float64(vec[i])
+	}
+	return out
+}
+
+func vectorNFromFloat64s(values []float64) VectorN {
+	out := make(VectorN, len(values))
+	for i := range values {
+		out[i] = float32(values[i])
+	}
+	return out
+}